@@ -0,0 +1,66 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// Anchor names a frame edge or corner a layout block can stick to.
+type Anchor int
+
+const (
+	AnchorTopLeft Anchor = iota
+	AnchorTopCenter
+	AnchorTopRight
+	AnchorCenterLeft
+	AnchorCenter
+	AnchorCenterRight
+	AnchorBottomLeft
+	AnchorBottomCenter
+	AnchorBottomRight
+)
+
+// AnchorRect resolves an anchored area against the current frame size:
+// the width x height rect sits at the anchor, shifted by the offsets —
+// positive offsets push inward from the edge (and right/down from a
+// center). HUD elements placed this way survive frame-size changes
+// without manual coordinate math.
+func AnchorRect(frame *gocv.Mat, anchor Anchor, offsetX, offsetY, width, height int) Rect {
+	var x, y int
+
+	switch anchor {
+	case AnchorTopLeft, AnchorCenterLeft, AnchorBottomLeft:
+		x = offsetX
+	case AnchorTopCenter, AnchorCenter, AnchorBottomCenter:
+		x = (frame.Cols()-width)/2 + offsetX
+	default:
+		x = frame.Cols() - width - offsetX
+	}
+
+	switch anchor {
+	case AnchorTopLeft, AnchorTopCenter, AnchorTopRight:
+		y = offsetY
+	case AnchorCenterLeft, AnchorCenter, AnchorCenterRight:
+		y = (frame.Rows()-height)/2 + offsetY
+	default:
+		y = frame.Rows() - height - offsetY
+	}
+
+	return Rect{x, y, width, height}
+}
+
+// BeginAnchored starts a column block anchored to a frame edge or
+// corner; close it with EndAnchored. Widgets inside flow top to bottom
+// from the resolved position:
+//
+//	gocvui.BeginAnchored(&frame, gocvui.AnchorTopRight, 10, 10, 120, 80)
+//	gocvui.Text("REC")
+//	gocvui.EndAnchored()
+func BeginAnchored(frame *gocv.Mat, anchor Anchor, offsetX, offsetY, width, height int) {
+	area := AnchorRect(frame, anchor, offsetX, offsetY, width, height)
+	internal.BeginBlock(frame, area.X, area.Y, area.Width, area.Height, 4, COLUMN)
+}
+
+// EndAnchored closes the block opened by BeginAnchored.
+func EndAnchored() {
+	internal.EndBlock()
+}
@@ -0,0 +1,65 @@
+package gocvui
+
+import (
+	"time"
+)
+
+// hoverAnimationDuration is how long widget fill colors take to settle
+// on a new interaction state.
+const hoverAnimationDuration = 100 * time.Millisecond
+
+// colorAnimation tracks the in-flight color of a single widget.
+type colorAnimation struct {
+	Current    [3]float64
+	LastUpdate time.Duration
+}
+
+// AnimatedColor returns the color to render right now for the widget
+// identified by id, moving the previously rendered color toward target
+// over duration. With animations disabled in the theme it simply
+// returns target.
+func (in *Internal) AnimatedColor(id string, target uint32, duration time.Duration) uint32 {
+	if theme.DisableAnimations {
+		return target
+	}
+
+	animation := in.State(id, func() interface{} {
+		return &colorAnimation{Current: colorComponents(target), LastUpdate: in.Clock.Now()}
+	}).(*colorAnimation)
+
+	now := in.Clock.Now()
+	elapsed := now - animation.LastUpdate
+	animation.LastUpdate = now
+
+	step := float64(elapsed) / float64(duration)
+	if step > 1 {
+		step = 1
+	}
+
+	want := colorComponents(target)
+	for i := range animation.Current {
+		animation.Current[i] += (want[i] - animation.Current[i]) * step
+	}
+	return componentsToColor(animation.Current)
+}
+
+func colorComponents(hexColor uint32) [3]float64 {
+	return [3]float64{
+		float64((hexColor >> 16) & 0xff),
+		float64((hexColor >> 8) & 0xff),
+		float64(hexColor & 0xff),
+	}
+}
+
+func componentsToColor(components [3]float64) uint32 {
+	clamp := func(v float64) uint32 {
+		if v < 0 {
+			return 0
+		}
+		if v > 255 {
+			return 255
+		}
+		return uint32(v + 0.5)
+	}
+	return clamp(components[0])<<16 | clamp(components[1])<<8 | clamp(components[2])
+}
@@ -0,0 +1,120 @@
+package gocvui
+
+import (
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// AnnotationMode selects how PolygonTool collects points.
+type AnnotationMode int
+
+const (
+	// AnnotationPolygon adds a vertex per click and closes the shape
+	// on a double click.
+	AnnotationPolygon AnnotationMode = iota
+	// AnnotationFreehand traces the cursor while the button is held
+	// and finishes on release.
+	AnnotationFreehand
+)
+
+const (
+	annotationDoubleClick  = 400 * time.Millisecond
+	annotationFreehandStep = 2.0
+)
+
+// polygonToolState tracks one annotation in progress.
+type polygonToolState struct {
+	Closed      bool
+	DragVertex  int
+	lastClickAt time.Duration
+}
+
+// PolygonTool collects an annotation outline over the frame into
+// *points — click out polygon vertices and close the shape with a
+// double click, or trace freehand while the button is held. A closed
+// shape keeps its vertices draggable for cleanup, the foundation for
+// labeling tools. Reset *points to an empty slice to start over.
+// Returns true on the frame the shape is closed.
+func PolygonTool(frame *gocv.Mat, points *[]Point, mode AnnotationMode) bool {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	state := internal.State(internal.StateID("polygontool", 0, 0), func() interface{} {
+		return &polygonToolState{DragVertex: -1}
+	}).(*polygonToolState)
+
+	if len(*points) == 0 {
+		state.Closed = false
+		state.DragVertex = -1
+	}
+
+	closed := false
+	if state.Closed {
+		editAnnotationVertices(state, points, mouse)
+	} else if mode == AnnotationFreehand {
+		if mouse.AnyButton.Pressed {
+			if len(*points) == 0 || pointDistance((*points)[len(*points)-1], mouse.Position) >= annotationFreehandStep {
+				*points = append(*points, mouse.Position)
+			}
+		} else if len(*points) >= 3 {
+			state.Closed = true
+			closed = true
+		}
+	} else if mouse.AnyButton.JustReleased {
+		now := internal.Clock.Now()
+		if now-state.lastClickAt < annotationDoubleClick && len(*points) >= 3 {
+			state.Closed = true
+			closed = true
+		} else {
+			*points = append(*points, mouse.Position)
+		}
+		state.lastClickAt = now
+	}
+
+	renderAnnotation(block, *points, state.Closed, mouse.Position)
+	return closed
+}
+
+// editAnnotationVertices runs the per-vertex drag of a closed shape.
+func editAnnotationVertices(state *polygonToolState, points *[]Point, mouse Mouse) {
+	if state.DragVertex < 0 && mouse.AnyButton.JustPressed {
+		for i, point := range *points {
+			if pointDistance(point, mouse.Position) <= zoneVertexHit {
+				state.DragVertex = i
+				break
+			}
+		}
+	}
+	if state.DragVertex >= 0 {
+		if !mouse.AnyButton.Pressed {
+			state.DragVertex = -1
+		} else {
+			(*points)[state.DragVertex] = mouse.Position
+		}
+	}
+}
+
+// renderAnnotation draws the outline, its vertices and — while still
+// open — the rubber line to the cursor.
+func renderAnnotation(block *Block, points []Point, closed bool, cursor Point) {
+	for i, point := range points {
+		if i > 0 {
+			render.line(block.Where, points[i-1], point, ColorHighlight)
+		}
+	}
+	if len(points) == 0 {
+		return
+	}
+	if closed {
+		render.line(block.Where, points[len(points)-1], points[0], ColorHighlight)
+		for _, point := range points {
+			render.circle(block.Where, point, zoneVertexRadius, ColorHighlight, CVUI_FILLED)
+		}
+		return
+	}
+	render.line(block.Where, points[len(points)-1], cursor, ColorDimText)
+	for _, point := range points {
+		render.circle(block.Where, point, zoneVertexRadius, ColorHighlight, 1)
+	}
+}
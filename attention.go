@@ -0,0 +1,88 @@
+package gocvui
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// AttentionStyle configures the pulsing effect applied by Attention.
+type AttentionStyle struct {
+	// Color of the pulsing outline.
+	Color uint32
+	// Duration the effect stays active after it is started.
+	Duration time.Duration
+	// Period of a single pulse cycle.
+	Period time.Duration
+}
+
+// DefaultAttentionStyle returns the stock attention effect: an amber
+// outline pulsing twice per second for three seconds.
+func DefaultAttentionStyle() AttentionStyle {
+	return AttentionStyle{
+		Color:    0xFFBC00,
+		Duration: 3 * time.Second,
+		Period:   500 * time.Millisecond,
+	}
+}
+
+// attentionEffect tracks a running attention effect across frames.
+type attentionEffect struct {
+	Start time.Duration
+}
+
+// Attention pulses an outline around area to draw the user's eye to it,
+// e.g. to highlight a Stop button when a fault is detected. The first
+// call starts the effect; subsequent calls with the same area keep
+// rendering it until its duration elapses. Returns true while the
+// effect is still active, so callers can stop invoking it afterwards.
+func Attention(frame *gocv.Mat, area Rect, style ...AttentionStyle) bool {
+	s := DefaultAttentionStyle()
+	if len(style) > 0 {
+		s = style[0]
+	}
+
+	id := fmt.Sprintf("%s/attention@%d,%d", internal.GetContext("").WindowName, area.X, area.Y)
+	effect := internal.State(id, func() interface{} {
+		return &attentionEffect{Start: internal.Clock.Now()}
+	}).(*attentionEffect)
+
+	elapsed := internal.Clock.Now() - effect.Start
+	if elapsed >= s.Duration {
+		delete(internal.States, id)
+		return false
+	}
+
+	// Intensity swings over one period; the outline fades in and out
+	// between the theme background and the style color.
+	phase := float64(elapsed%s.Period) / float64(s.Period)
+	intensity := 0.5 - 0.5*math.Cos(2*math.Pi*phase)
+
+	block := internal.ScreenBlock(frame)
+	outline := Rect{area.X - 2, area.Y - 2, area.Width + 4, area.Height + 4}
+	hexColor := lerpColor(theme.ButtonBorder, s.Color, intensity)
+
+	internal.QueueOverlay(func() {
+		render.rectangle(block.Where, outline, hexColor, 1)
+		render.rectangle(block.Where, Rect{outline.X - 1, outline.Y - 1, outline.Width + 2, outline.Height + 2}, hexColor, 1)
+	})
+	return true
+}
+
+// lerpColor blends two hex colors; t is clamped to [0, 1].
+func lerpColor(from, to uint32, t float64) uint32 {
+	if t < 0 {
+		t = 0
+	}
+	if t > 1 {
+		t = 1
+	}
+	a := colorComponents(from)
+	b := colorComponents(to)
+	for i := range a {
+		a[i] += (b[i] - a[i]) * t
+	}
+	return componentsToColor(a)
+}
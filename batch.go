@@ -0,0 +1,95 @@
+package gocvui
+
+import (
+	"sort"
+
+	"gocv.io/x/gocv"
+)
+
+// UpdateAll runs the Update cycle of every watched window in one call,
+// resetting the per-frame input state of each context. The internal
+// WaitKey pump runs once at the end, not per window. Dashboards with
+// many windows call this instead of one Update per name.
+func UpdateAll() {
+	for _, name := range watchedWindowNames() {
+		internal.Update(name)
+	}
+	pumpWaitKey()
+}
+
+// ImshowAll presents a frame per window name in one call, running the
+// Update cycle of each and pumping WaitKey once: the batch equivalent
+// of Imshow. Names without a watched window are reported through the
+// error handler like Imshow does.
+func ImshowAll(frames map[string]gocv.Mat) {
+	names := make([]string, 0, len(frames))
+	for name := range frames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		internal.Update(name)
+		window, ok := internal.Windows[name]
+		if !ok {
+			internal.Fail(ErrUnknownWindow, "ImshowAll", "\""+name+"\" was not created by Init()/Watch()")
+			continue
+		}
+		window.IMShow(frames[name])
+	}
+	pumpWaitKey()
+}
+
+// pumpWaitKey runs the internal WaitKey call once, honoring step mode,
+// which blocks until a key advances to the next frame. It does nothing
+// when the application feeds keys itself through SetLastKey.
+func pumpWaitKey() {
+	if internal.externalKeys || internal.DelayWaitKey <= 0 {
+		return
+	}
+	if internal.stepMode {
+		internal.LastKeyPressed = gocv.WaitKey(0)
+	} else {
+		internal.LastKeyPressed = gocv.WaitKey(internal.DelayWaitKey)
+	}
+	internal.routeKey(internal.LastKeyPressed)
+	internal.updateKeyboard(internal.LastKeyPressed)
+	internal.dispatchShortcuts(internal.LastKeyPressed)
+}
+
+// SetLastKey feeds gocvui the key the application already read from
+// its own gocv.WaitKey call (or -1 for none) and switches the library
+// to external event loop mode: from here on Update never calls WaitKey
+// itself. Call it once per frame, before Update, so shortcuts and the
+// keyboard state API see the key. Step mode, which relies on the
+// internal WaitKey to block between frames, has no effect in this
+// mode:
+//
+//	key := gocv.WaitKey(20)
+//	gocvui.SetLastKey(key)
+//	gocvui.Update()
+func SetLastKey(key int) {
+	internal.externalKeys = true
+	internal.LastKeyPressed = key
+	internal.routeKey(key)
+	internal.updateKeyboard(key)
+	internal.dispatchShortcuts(key)
+}
+
+// UpdateWithKey is SetLastKey followed by Update, for event loops that
+// keep the two in one call.
+func UpdateWithKey(key int, windowName ...string) {
+	SetLastKey(key)
+	Update(windowName...)
+}
+
+// watchedWindowNames returns the names of all watched contexts in a
+// stable order.
+func watchedWindowNames() []string {
+	names := make([]string, 0, len(internal.Contexts))
+	for name := range internal.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
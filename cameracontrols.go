@@ -0,0 +1,101 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	cameraControlWidth   = 180
+	cameraControlRowGap  = 4
+	cameraUnsupportedGet = -1
+	cameraAutoOn         = 3
+	cameraAutoOff        = 1
+)
+
+// cameraProperty describes one VideoCapture property exposed by
+// CameraControls. A zero Auto means the property has no auto mode.
+type cameraProperty struct {
+	Label string
+	Prop  gocv.VideoCaptureProperties
+	Auto  gocv.VideoCaptureProperties
+	Min   float64
+	Max   float64
+}
+
+// cameraProperties are the controls rendered by CameraControls, in
+// order. The ranges are the common UVC ones; drivers clamp out-of-range
+// sets themselves.
+var cameraProperties = []cameraProperty{
+	{"Brightness", gocv.VideoCaptureBrightness, 0, 0, 255},
+	{"Exposure", gocv.VideoCaptureExposure, gocv.VideoCaptureAutoExposure, -13, 0},
+	{"Gain", gocv.VideoCaptureGain, 0, 0, 255},
+	{"White balance", gocv.VideoCaptureWBTemperature, gocv.VideoCaptureAutoWB, 2800, 6500},
+	{"Focus", gocv.VideoCaptureFocus, gocv.VideoCaptureAutoFocus, 0, 255},
+}
+
+// cameraControlsState caches the probed values so the capture device is
+// only queried once, not every frame.
+type cameraControlsState struct {
+	Probed bool
+	Values map[string]float64
+	Auto   map[string]bool
+}
+
+// CameraControls renders an exposure/gain panel for a capture device:
+// brightness, exposure, gain, white balance and focus as trackbars
+// wired to the VideoCapture properties, each with an auto/manual toggle
+// where the backend has one. Properties the device reports as
+// unsupported (a -1 read) are shown dimmed and left alone.
+func CameraControls(frame *gocv.Mat, x, y int, cap *gocv.VideoCapture) {
+	block := internal.ScreenBlock(frame)
+
+	state := internal.State(internal.StateID("cameracontrols", x, y), func() interface{} {
+		return &cameraControlsState{Values: map[string]float64{}, Auto: map[string]bool{}}
+	}).(*cameraControlsState)
+
+	if !state.Probed {
+		for _, property := range cameraProperties {
+			state.Values[property.Label] = cap.Get(property.Prop)
+			if property.Auto != 0 {
+				state.Auto[property.Label] = cap.Get(property.Auto) >= cameraAutoOn
+			}
+		}
+		state.Probed = true
+	}
+
+	rowY := y
+	for _, property := range cameraProperties {
+		value := state.Values[property.Label]
+		if value == cameraUnsupportedGet {
+			internal.Text(block, x, rowY+6, property.Label+": n/a", 0.4, ColorDimText, false)
+			rowY += 20 + cameraControlRowGap
+			continue
+		}
+
+		internal.Text(block, x, rowY+6, property.Label, 0.4, ColorText, false)
+		if property.Auto != 0 {
+			auto := state.Auto[property.Label]
+			if internal.Checkbox(block, x+cameraControlWidth+10, rowY, "auto", &auto, ColorText, false) {
+				state.Auto[property.Label] = auto
+				if auto {
+					cap.Set(property.Auto, cameraAutoOn)
+				} else {
+					cap.Set(property.Auto, cameraAutoOff)
+					cap.Set(property.Prop, value)
+				}
+			}
+		}
+		rowY += 14
+
+		params := TrackbarParams{Min: property.Min, Max: property.Max, LabelFormat: "%.0f"}
+		if state.Auto[property.Label] {
+			value = cap.Get(property.Prop)
+			state.Values[property.Label] = value
+			internal.Trackbar(block, x, rowY, cameraControlWidth, &value, params, false)
+		} else if internal.Trackbar(block, x, rowY, cameraControlWidth, &value, params, false) {
+			state.Values[property.Label] = value
+			cap.Set(property.Prop, value)
+		}
+		rowY += 30 + cameraControlRowGap
+	}
+}
@@ -0,0 +1,147 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	chromeTitleBarHeight = 20
+	chromeButtonSize     = 14
+	chromeButtonGap      = 3
+)
+
+// WindowButton identifies a title bar button of a Panel.
+type WindowButton int
+
+const (
+	WindowButtonNone WindowButton = iota
+	WindowButtonClose
+	WindowButtonMinimize
+	WindowButtonPin
+)
+
+// WindowChrome configures the title bar of a Panel: which buttons it
+// carries and whether the panel renders as the active one.
+type WindowChrome struct {
+	Closable    bool
+	Collapsible bool
+	Pinnable    bool
+	Active      bool
+}
+
+// PanelState is the caller-owned state of a Panel, toggled by its title
+// bar buttons.
+type PanelState struct {
+	Collapsed bool
+	Pinned    bool
+}
+
+// Panel renders a window with configurable title bar chrome. Clicking
+// the minimize button collapses the panel to its title bar; the pin
+// button toggles state.Pinned. The button clicked this frame, if any,
+// is returned so callers can react, e.g. by no longer rendering a
+// closed panel. The title bar color follows the theme's active or
+// inactive color depending on chrome.Active.
+func Panel(frame *gocv.Mat, x, y, width, height int, title string, chrome WindowChrome, state *PanelState) WindowButton {
+	block := internal.ScreenBlock(frame)
+
+	titleBar := Rect{x, y, width, chromeTitleBarHeight}
+	barColor := theme.TitleBarInactive
+	if chrome.Active {
+		barColor = theme.TitleBarActive
+	}
+
+	render.rectangle(block.Where, titleBar, barColor, CVUI_FILLED)
+	render.rectangle(block.Where, titleBar, ColorOutline, 1)
+	size := render.GetTextSize(title, 0.4)
+	render.putText(block.Where, title, Point{titleBar.X + 5, titleBar.Y + size.Height + (titleBar.Height-size.Height)/2 - 1}, 0.4, theme.TextColor)
+
+	if !state.Collapsed {
+		content := Rect{x, y + chromeTitleBarHeight, width, height - chromeTitleBarHeight}
+		render.rectangle(block.Where, content, ColorPanel, CVUI_FILLED)
+		render.rectangle(block.Where, content, ColorOutline, 1)
+	}
+
+	clicked := WindowButtonNone
+	buttonX := titleBar.X + titleBar.Width - chromeButtonGap - chromeButtonSize
+	buttonY := titleBar.Y + (titleBar.Height-chromeButtonSize)/2
+
+	if chrome.Closable {
+		if renderChromeButton(block, WindowButtonClose, Rect{buttonX, buttonY, chromeButtonSize, chromeButtonSize}, state) {
+			clicked = WindowButtonClose
+		}
+		buttonX -= chromeButtonSize + chromeButtonGap
+	}
+	if chrome.Collapsible {
+		shape := Rect{buttonX, buttonY, chromeButtonSize, chromeButtonSize}
+		if renderChromeButton(block, WindowButtonMinimize, shape, state) {
+			clicked = WindowButtonMinimize
+			state.Collapsed = !state.Collapsed
+		}
+		buttonX -= chromeButtonSize + chromeButtonGap
+	}
+	if chrome.Pinnable {
+		shape := Rect{buttonX, buttonY, chromeButtonSize, chromeButtonSize}
+		if renderChromeButton(block, WindowButtonPin, shape, state) {
+			clicked = WindowButtonPin
+			state.Pinned = !state.Pinned
+		}
+	}
+
+	internal.Describe("panel", title, "", OUT, Rect{x, y, width, height})
+	return clicked
+}
+
+// WindowWithButtons is the bool-flag variant of Panel for the common
+// floating tool panel: pass pointers for the buttons wanted — open for
+// a close button, collapsed for a minimize button, either may be nil —
+// and the window updates them when clicked. Nothing renders while
+// *open is false, so callers can keep the call in the frame loop
+// unconditionally.
+func WindowWithButtons(frame *gocv.Mat, x, y, width, height int, title string, open, collapsed *bool) {
+	if open != nil && !*open {
+		return
+	}
+
+	state := internal.State(internal.StateID("windowbuttons", x, y), func() interface{} {
+		return &PanelState{}
+	}).(*PanelState)
+	if collapsed != nil {
+		state.Collapsed = *collapsed
+	}
+
+	chrome := WindowChrome{Closable: open != nil, Collapsible: collapsed != nil, Active: true}
+	switch Panel(frame, x, y, width, height, title, chrome, state) {
+	case WindowButtonClose:
+		*open = false
+	case WindowButtonMinimize:
+		*collapsed = state.Collapsed
+	}
+}
+
+// renderChromeButton renders one title bar button and reports a click.
+func renderChromeButton(block *Block, button WindowButton, shape Rect, state *PanelState) bool {
+	status := internal.IArea(shape.X, shape.Y, shape.Width, shape.Height)
+
+	if status == OVER || status == DOWN {
+		render.rectangle(block.Where, shape, ColorBorder, CVUI_FILLED)
+	}
+
+	glyphColor := theme.TextColor
+	switch button {
+	case WindowButtonClose:
+		render.line(block.Where, Point{shape.X + 4, shape.Y + 4}, Point{shape.X + shape.Width - 5, shape.Y + shape.Height - 5}, glyphColor)
+		render.line(block.Where, Point{shape.X + shape.Width - 5, shape.Y + 4}, Point{shape.X + 4, shape.Y + shape.Height - 5}, glyphColor)
+	case WindowButtonMinimize:
+		render.line(block.Where, Point{shape.X + 4, shape.Y + shape.Height - 5}, Point{shape.X + shape.Width - 5, shape.Y + shape.Height - 5}, glyphColor)
+	case WindowButtonPin:
+		center := Point{shape.X + shape.Width/2, shape.Y + shape.Height/2}
+		thickness := 1
+		if state.Pinned {
+			thickness = CVUI_FILLED
+			glyphColor = theme.CheckboxCheck
+		}
+		render.circle(block.Where, center, 3, glyphColor, thickness)
+	}
+	return status == CLICK
+}
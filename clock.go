@@ -0,0 +1,63 @@
+package gocvui
+
+import "time"
+
+// Clock is the frame timing service of the library, ticked once per
+// Update call. Animations, spinners, blink effects and double-click
+// timing read it instead of the wall clock directly, so every widget
+// of a frame sees the same instant and behavior stays consistent
+// regardless of FPS.
+type Clock struct {
+	start      time.Time
+	frameStart time.Time
+	delta      time.Duration
+	frame      int
+}
+
+// tick advances the clock to a new frame.
+func (c *Clock) tick() {
+	now := time.Now()
+	if c.start.IsZero() {
+		c.start = now
+		c.frameStart = now
+	}
+	c.delta = now.Sub(c.frameStart)
+	c.frameStart = now
+	c.frame++
+}
+
+// Delta returns the duration of the previous frame.
+func (c *Clock) Delta() time.Duration {
+	return c.delta
+}
+
+// Now returns the time elapsed since the clock started ticking. It
+// only advances between frames.
+func (c *Clock) Now() time.Duration {
+	if c.start.IsZero() {
+		return 0
+	}
+	return c.frameStart.Sub(c.start)
+}
+
+// Frame returns the number of frames ticked so far.
+func (c *Clock) Frame() int {
+	return c.frame
+}
+
+// DeltaTime returns the duration of the previous frame, i.e. the span
+// between the two most recent Update calls.
+func DeltaTime() time.Duration {
+	return internal.Clock.Delta()
+}
+
+// Now returns the total time the UI has been running, advancing once
+// per frame.
+func Now() time.Duration {
+	return internal.Clock.Now()
+}
+
+// FrameIndex returns the number of frames rendered so far.
+func FrameIndex() int {
+	return internal.Clock.Frame()
+}
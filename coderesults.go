@@ -0,0 +1,100 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	codeListWidth     = 180
+	codeListRowHeight = 20
+	codeCopyWidth     = 16
+)
+
+// CodeDetection is one decoded barcode or QR code: its decoded payload
+// and the corner points of the detected polygon, as produced by gocv's
+// QRCodeDetector.
+type CodeDetection struct {
+	Text   string
+	Points []Point
+}
+
+// CodeResults overlays detected code polygons and their decoded text
+// on the frame and renders a side list of all detections, each with a
+// copy button. onCopy is invoked with the decoded text when one is
+// clicked; pass nil to hide the copy buttons. Returns the index of the
+// list entry clicked this frame, or -1.
+func CodeResults(frame *gocv.Mat, results []CodeDetection, onCopy func(text string)) int {
+	block := internal.ScreenBlock(frame)
+
+	for _, result := range results {
+		renderCodePolygon(block, result)
+	}
+
+	list := Rect{frame.Cols() - codeListWidth, 0, codeListWidth, len(results)*codeListRowHeight + 4}
+	if len(results) == 0 {
+		return -1
+	}
+	render.rectangle(block.Where, list, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, list, ColorBorder, 1)
+
+	clicked := -1
+	for i, result := range results {
+		row := Rect{list.X + 2, list.Y + 2 + i*codeListRowHeight, list.Width - 4, codeListRowHeight}
+		status := internal.IArea(row.X, row.Y, row.Width, row.Height)
+		if status == OVER || status == DOWN {
+			render.rectangle(block.Where, row, ColorPanel, CVUI_FILLED)
+		}
+		if status == CLICK {
+			clicked = i
+		}
+
+		text := result.Text
+		limit := row.Width - 12
+		if onCopy != nil {
+			limit -= codeCopyWidth
+		}
+		for render.GetTextSize(text, 0.4).Width > limit && len(text) > 1 {
+			text = text[:len(text)-1]
+		}
+		size := render.GetTextSize(text, 0.4)
+		render.putText(block.Where, text, Point{row.X + 4, row.Y + row.Height/2 + size.Height/2}, 0.4, theme.TextColor)
+
+		if onCopy != nil {
+			copyArea := Rect{row.X + row.Width - codeCopyWidth - 2, row.Y + 3, codeCopyWidth, row.Height - 6}
+			copyStatus := internal.IArea(copyArea.X, copyArea.Y, copyArea.Width, copyArea.Height)
+			hexColor := ColorDimText
+			if copyStatus != OUT {
+				hexColor = theme.CheckboxCheck
+			}
+			render.rectangle(block.Where, Rect{copyArea.X + 2, copyArea.Y + 2, 8, 8}, hexColor, 1)
+			render.rectangle(block.Where, Rect{copyArea.X + 5, copyArea.Y + 5, 8, 8}, hexColor, 1)
+			if copyStatus == CLICK {
+				onCopy(result.Text)
+			}
+		}
+	}
+	return clicked
+}
+
+// renderCodePolygon outlines one detection and labels it with its
+// decoded text near the top-left corner.
+func renderCodePolygon(block *Block, result CodeDetection) {
+	if len(result.Points) < 2 {
+		return
+	}
+	topLeft := result.Points[0]
+	for i, point := range result.Points {
+		next := result.Points[(i+1)%len(result.Points)]
+		render.line(block.Where, point, next, theme.CheckboxCheck)
+		if point.Y < topLeft.Y || (point.Y == topLeft.Y && point.X < topLeft.X) {
+			topLeft = point
+		}
+	}
+
+	if result.Text != "" {
+		size := render.GetTextSize(result.Text, 0.4)
+		label := Rect{topLeft.X, topLeft.Y - size.Height - 8, size.Width + 8, size.Height + 6}
+		render.rectangle(block.Where, label, ColorInset, CVUI_FILLED)
+		render.putText(block.Where, result.Text, Point{label.X + 4, label.Y + label.Height - 3}, 0.4, theme.TextColor)
+	}
+}
@@ -0,0 +1,49 @@
+package gocvui
+
+const collapsingHeaderHeight = 20
+
+// CollapsingHeader renders a clickable header bar inside the current
+// begin*/end* block and returns whether its content should be shown, so
+// long control panels can be folded:
+//
+//	BeginColumn(frame, x, y, width, -1, 4)
+//	if CollapsingHeader("Advanced", &open) {
+//		... collapsible components ...
+//	}
+//	EndColumn()
+func CollapsingHeader(label string, open *bool) bool {
+	block := internal.TopBlock()
+
+	width := block.Rect.Width
+	size := render.GetTextSize(label, 0.4)
+	if width <= 0 {
+		width = size.Width + 30
+	}
+
+	header := Rect{block.Anchor.X, block.Anchor.Y, width, collapsingHeaderHeight}
+	status := internal.IArea(header.X, header.Y, header.Width, header.Height)
+	if status == CLICK {
+		*open = !*open
+	}
+
+	fill := uint32(0x383838)
+	if status == OVER || status == DOWN {
+		fill = 0x424242
+	}
+	render.rectangle(block.Where, header, fill, CVUI_FILLED)
+	render.rectangle(block.Where, header, 0x45494A, 1)
+
+	midY := header.Y + header.Height/2
+	arrowX := header.X + 6
+	if *open {
+		render.line(block.Where, Point{arrowX, midY - 2}, Point{arrowX + 4, midY + 2}, theme.TextColor)
+		render.line(block.Where, Point{arrowX + 4, midY + 2}, Point{arrowX + 8, midY - 2}, theme.TextColor)
+	} else {
+		render.line(block.Where, Point{arrowX + 2, midY - 4}, Point{arrowX + 6, midY}, theme.TextColor)
+		render.line(block.Where, Point{arrowX + 6, midY}, Point{arrowX + 2, midY + 4}, theme.TextColor)
+	}
+	render.putText(block.Where, label, Point{arrowX + 14, midY + size.Height/2}, 0.4, theme.TextColor)
+
+	internal.UpdateLayoutFlow(block, Size{width, collapsingHeaderHeight})
+	return *open
+}
@@ -0,0 +1,41 @@
+package gocvui
+
+import "image/color"
+
+// Named colors of the default look. Components that hard-code a color
+// should pick it from here so the palette stays consistent.
+const (
+	ColorText      uint32 = 0xCECECE
+	ColorDimText   uint32 = 0x767676
+	ColorHighlight uint32 = 0xFFBC00
+	ColorInset     uint32 = 0x292929
+	ColorPanel     uint32 = 0x313131
+	ColorTitleBar  uint32 = 0x4A4A4A
+	ColorBorder    uint32 = 0x45494A
+	ColorOutline   uint32 = 0x202020
+)
+
+// RGB interprets hex as 0xRRGGBB and returns the color struct expected
+// by the gocv drawing calls, which swap the channels into OpenCV's BGR
+// order internally. This is the conversion used by every Render call,
+// so colors passed to the library as 0xRRGGBB come out right.
+func RGB(hex uint32) color.RGBA {
+	return color.RGBA{
+		R: uint8((hex >> 16) & 0xff),
+		G: uint8((hex >> 8) & 0xff),
+		B: uint8(hex & 0xff),
+		A: 255,
+	}
+}
+
+// BGR interprets hex as 0xBBGGRR, the channel order OpenCV uses in
+// memory, for callers porting code that already carries BGR-packed
+// colors.
+func BGR(hex uint32) color.RGBA {
+	return color.RGBA{
+		B: uint8((hex >> 16) & 0xff),
+		G: uint8((hex >> 8) & 0xff),
+		R: uint8(hex & 0xff),
+		A: 255,
+	}
+}
@@ -0,0 +1,102 @@
+package gocvui
+
+import (
+	"image"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	colorBalanceRange        = 100.0
+	colorBalanceGainSpan     = 0.3
+	colorBalancePreviewWidth = 200
+)
+
+// ColorBalanceState is the application-owned state of a ColorBalance
+// widget: temperature and tint in -100..100, zero meaning neutral.
+type ColorBalanceState struct {
+	Temperature float64
+	Tint        float64
+}
+
+// Gains returns the per-channel BGR gains of the current correction,
+// for pipelines that apply it as a multiplication instead of a LUT.
+// Positive temperature warms the image (more red, less blue); positive
+// tint shifts green against magenta.
+func (s *ColorBalanceState) Gains() (b, g, r float64) {
+	temperature := s.Temperature / colorBalanceRange
+	tint := s.Tint / colorBalanceRange
+	b = 1 - temperature*colorBalanceGainSpan
+	g = 1 + tint*colorBalanceGainSpan
+	r = 1 + temperature*colorBalanceGainSpan
+	return b, g, r
+}
+
+// LUTMat builds the 256-entry BGR lookup table of the current
+// correction, ready for gocv.LUT on the full-resolution pipeline. The
+// caller closes the returned Mat.
+func (s *ColorBalanceState) LUTMat() gocv.Mat {
+	b, g, r := s.Gains()
+	lut := gocv.NewMatWithSize(1, 256, gocv.MatTypeCV8UC3)
+	for i := 0; i < 256; i++ {
+		lut.SetUCharAt(0, i*3, lutEntry(i, b))
+		lut.SetUCharAt(0, i*3+1, lutEntry(i, g))
+		lut.SetUCharAt(0, i*3+2, lutEntry(i, r))
+	}
+	return lut
+}
+
+// ColorBalance renders temperature and tint trackbars with a live
+// before/after split preview of the supplied Mat — original left half,
+// corrected right half. The correction itself stays with the caller:
+// read Gains or LUTMat off the state and apply it to the full
+// pipeline. Returns true when either value changed.
+func ColorBalance(frame *gocv.Mat, x, y int, state *ColorBalanceState, preview gocv.Mat) bool {
+	block := internal.ScreenBlock(frame)
+
+	changed := false
+	params := TrackbarParams{Min: -colorBalanceRange, Max: colorBalanceRange, LabelFormat: "%.0f"}
+	internal.Text(block, x, y+6, "Temperature", 0.4, ColorText, false)
+	if internal.Trackbar(block, x, y+12, colorBalancePreviewWidth, &state.Temperature, params, false) {
+		changed = true
+	}
+	internal.Text(block, x, y+48, "Tint", 0.4, ColorText, false)
+	if internal.Trackbar(block, x, y+54, colorBalancePreviewWidth, &state.Tint, params, false) {
+		changed = true
+	}
+
+	if !preview.Empty() && preview.Cols() > 0 {
+		renderColorBalancePreview(block, x, y+92, state, preview)
+	}
+	return changed
+}
+
+// renderColorBalancePreview draws the split thumbnail: the left half
+// untouched, the right half through the correction LUT.
+func renderColorBalancePreview(block *Block, x, y int, state *ColorBalanceState, preview gocv.Mat) {
+	height := colorBalancePreviewWidth * preview.Rows() / preview.Cols()
+	thumb := gocv.NewMat()
+	gocv.Resize(preview, &thumb, image.Pt(colorBalancePreviewWidth, maxInt(height, 1)), 0, 0, gocv.InterpolationArea)
+
+	corrected := gocv.NewMat()
+	lut := state.LUTMat()
+	gocv.LUT(thumb, lut, &corrected)
+	lut.Close()
+
+	half := corrected.Region(image.Rect(thumb.Cols()/2, 0, thumb.Cols(), thumb.Rows()))
+	render.Image(block, Rect{x, y, thumb.Cols(), thumb.Rows()}, thumb)
+	render.Image(block, Rect{x + thumb.Cols()/2, y, half.Cols(), half.Rows()}, half)
+	half.Close()
+	corrected.Close()
+	thumb.Close()
+
+	split := Point{x + colorBalancePreviewWidth/2, y}
+	render.line(block.Where, split, Point{split.X, y + height}, ColorBorder)
+	render.rectangle(block.Where, Rect{x, y, colorBalancePreviewWidth, height}, ColorBorder, 1)
+}
+
+// lutEntry applies a channel gain to one 8-bit level.
+func lutEntry(level int, gain float64) uint8 {
+	return uint8(math.Round(clampFloat(float64(level)*gain, 0, 255)))
+}
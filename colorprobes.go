@@ -0,0 +1,123 @@
+package gocvui
+
+import (
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	probeDefaultSize = 8
+	probeLabelOffset = 6
+)
+
+// probesState tracks the probe being dragged.
+type probesState struct {
+	Drag int
+}
+
+// Probe is one persistent color sample patch, owned by the
+// application. Low and High are optional per-channel bounds as
+// 0xRRGGBB values: when High is non-zero, a probe whose mean drifts
+// outside the range on any channel is flagged.
+type Probe struct {
+	Position Point
+	// Size is the patch edge length; zero means 8.
+	Size  int
+	Label string
+	Low   uint32
+	High  uint32
+}
+
+// ColorProbes renders sample patches on an image viewer, each showing
+// the live mean BGR and HSV of the pixels of img under its patch and a
+// drift flag when a channel leaves the probe's bounds. Probes drag
+// with the mouse; the application owns the slice, so placing and
+// removing probes is an append or delete away.
+func ColorProbes(frame *gocv.Mat, img gocv.Mat, probes []*Probe) {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	state := internal.State(internal.StateID("colorprobes", 0, 0), func() interface{} {
+		return &probesState{Drag: -1}
+	}).(*probesState)
+
+	if state.Drag >= 0 {
+		if state.Drag >= len(probes) || !mouse.AnyButton.Pressed {
+			state.Drag = -1
+		} else {
+			probes[state.Drag].Position = mouse.Position
+		}
+	}
+
+	for i, probe := range probes {
+		size := probe.Size
+		if size <= 0 {
+			size = probeDefaultSize
+		}
+		patch := Rect{probe.Position.X - size/2, probe.Position.Y - size/2, size, size}
+
+		if state.Drag < 0 && mouse.AnyButton.JustPressed && patch.Contains(mouse.Position) {
+			state.Drag = i
+		}
+
+		b, g, r, ok := probeMean(img, patch)
+		outline := ColorHighlight
+		drifted := false
+		if ok && probe.High != 0 {
+			drifted = probeDrifted(b, g, r, probe.Low, probe.High)
+			if drifted {
+				outline = 0xFF4040
+			}
+		}
+		render.rectangle(block.Where, patch, outline, 1)
+
+		if !ok {
+			continue
+		}
+		h, s, v := bgrToHsv(uint8(b), uint8(g), uint8(r))
+		label := probe.Label
+		if label != "" {
+			label += " "
+		}
+		line := fmt.Sprintf("%sBGR %d %d %d  HSV %d %d %d", label, b, g, r, h, s, v)
+		if drifted {
+			line += " !"
+		}
+		render.putText(block.Where, line, Point{patch.X + patch.Width + probeLabelOffset, probe.Position.Y + 3}, 0.3, outline)
+	}
+}
+
+// probeMean averages the image pixels under a patch; ok is false when
+// the patch lies outside the image.
+func probeMean(img gocv.Mat, patch Rect) (b, g, r int, ok bool) {
+	sumB, sumG, sumR, count := 0, 0, 0, 0
+	for row := patch.Y; row < patch.Y+patch.Height; row++ {
+		for col := patch.X; col < patch.X+patch.Width; col++ {
+			if row < 0 || col < 0 || row >= img.Rows() || col >= img.Cols() {
+				continue
+			}
+			pixel := img.GetVecbAt(row, col)
+			if len(pixel) < 3 {
+				return 0, 0, 0, false
+			}
+			sumB += int(pixel[0])
+			sumG += int(pixel[1])
+			sumR += int(pixel[2])
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, 0, 0, false
+	}
+	return sumB / count, sumG / count, sumR / count, true
+}
+
+// probeDrifted checks the mean against the per-channel bounds.
+func probeDrifted(b, g, r int, low, high uint32) bool {
+	lowColor := RGB(low)
+	highColor := RGB(high)
+	return b < int(lowColor.B) || b > int(highColor.B) ||
+		g < int(lowColor.G) || g > int(highColor.G) ||
+		r < int(lowColor.R) || r > int(highColor.R)
+}
@@ -0,0 +1,149 @@
+package gocvui
+
+import (
+	"strings"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	paletteWidth      = 300
+	paletteTop        = 40
+	paletteRowHeight  = 20
+	paletteBoxHeight  = 24
+	paletteMaxVisible = 8
+	paletteOpenKey    = 16 // Ctrl+P as reported by waitKey
+)
+
+// Command is an action offered through the command palette.
+type Command struct {
+	Name   string
+	Action func()
+}
+
+// commandPaletteState keeps the palette open across frames along with
+// its search text and highlighted row.
+type commandPaletteState struct {
+	Open     bool
+	Input    TextInputState
+	Selected int
+}
+
+// CommandPalette renders a fuzzy-searchable overlay list of commands,
+// opened with Ctrl+P. Typing filters the list, clicking an entry or
+// pressing Enter on the highlighted one invokes its callback, and Esc
+// closes the palette. Call it every frame; it is handy for tools with
+// many functions and few toolbar slots.
+func CommandPalette(frame *gocv.Mat, commands []Command) {
+	state := internal.State(internal.StateID("palette", 0, 0), func() interface{} {
+		return &commandPaletteState{}
+	}).(*commandPaletteState)
+
+	if !state.Open {
+		if internal.LastKeyPressed == paletteOpenKey {
+			state.Open = true
+			state.Input = TextInputState{}
+			state.Selected = 0
+		}
+		return
+	}
+
+	matches := fuzzyFilter(commands, state.Input.Text)
+	if state.Selected >= len(matches) {
+		state.Selected = 0
+	}
+
+	switch state.Input.Feed(internal.LastKeyPressed, false) {
+	case EDIT_COMMIT:
+		if state.Selected < len(matches) {
+			run := matches[state.Selected].Action
+			state.Open = false
+			if run != nil {
+				run()
+			}
+		}
+		return
+	case EDIT_CANCEL:
+		state.Open = false
+		return
+	}
+
+	x := (frame.Cols() - paletteWidth) / 2
+	visible := len(matches)
+	if visible > paletteMaxVisible {
+		visible = paletteMaxVisible
+	}
+	area := Rect{x, paletteTop, paletteWidth, paletteBoxHeight + visible*paletteRowHeight + 4}
+
+	// The palette is an overlay: its rows win the mouse over whatever
+	// renders underneath them.
+	OverlayPass(area, func() {
+		renderCommandPalette(frame, state, matches, area, visible)
+	})
+}
+
+// fuzzyFilter returns the commands whose names contain the characters
+// of query in order, case-insensitively.
+func fuzzyFilter(commands []Command, query string) []Command {
+	if query == "" {
+		return commands
+	}
+	var matches []Command
+	for _, command := range commands {
+		if fuzzyMatch(strings.ToLower(query), strings.ToLower(command.Name)) {
+			matches = append(matches, command)
+		}
+	}
+	return matches
+}
+
+// fuzzyMatch reports whether query is a subsequence of name.
+func fuzzyMatch(query, name string) bool {
+	i := 0
+	for _, c := range name {
+		if i < len(query) && rune(query[i]) == c {
+			i++
+		}
+	}
+	return i == len(query)
+}
+
+// renderCommandPalette draws the search box and filtered command rows
+// as an overlay centered near the top of the frame.
+func renderCommandPalette(frame *gocv.Mat, state *commandPaletteState, matches []Command, area Rect, visible int) {
+	block := internal.ScreenBlock(frame)
+	x := area.X
+
+	render.rectangle(block.Where, area, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorBorder, 1)
+
+	render.EditBox(block, Rect{x + 2, paletteTop + 2, paletteWidth - 4, paletteBoxHeight - 2}, state.Input.Text)
+
+	for i := 0; i < visible; i++ {
+		row := Rect{x + 2, paletteTop + paletteBoxHeight + 2 + i*paletteRowHeight, paletteWidth - 4, paletteRowHeight}
+		status := internal.IArea(row.X, row.Y, row.Width, row.Height)
+		if status == OVER || status == DOWN {
+			state.Selected = i
+		}
+
+		if i == state.Selected {
+			render.rectangle(block.Where, row, ColorPanel, CVUI_FILLED)
+			render.rectangle(block.Where, row, ColorBorder, 1)
+		}
+		hexColor := theme.TextColor
+		if i == state.Selected {
+			hexColor = theme.CheckboxCheck
+		}
+		size := render.GetTextSize(matches[i].Name, 0.4)
+		render.putText(block.Where, matches[i].Name, Point{row.X + 6, row.Y + row.Height/2 + size.Height/2}, 0.4, hexColor)
+
+		if status == CLICK {
+			run := matches[i].Action
+			state.Open = false
+			if run != nil {
+				run()
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package gocvui
+
+import (
+	"fmt"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+// compassSnapDegrees is the step the compass snaps to when snapping is
+// engaged.
+const compassSnapDegrees = 15.0
+
+// compassDrag tracks an in-flight compass drag across frames.
+type compassDrag struct {
+	Active bool
+}
+
+// Compass renders a circular angle selector centered at (x, y). While
+// dragged, the bound angle follows the direction of the mouse relative
+// to the center, in degrees in the 0..360 range with 0 pointing right
+// — useful for rotation parameters. Holding Shift snaps the angle to
+// 15 degree steps; passing snap overrides the key and forces snapping
+// on or off. Returns true when the angle changed this frame.
+func Compass(frame *gocv.Mat, x, y, radius int, angle *float64, snap ...bool) bool {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	state := internal.State(internal.StateID("compass", x, y), func() interface{} {
+		return &compassDrag{}
+	}).(*compassDrag)
+
+	hitArea := Rect{x - radius, y - radius, 2 * radius, 2 * radius}
+	status := internal.IArea(hitArea.X, hitArea.Y, hitArea.Width, hitArea.Height)
+
+	if !state.Active && status == DOWN && mouse.AnyButton.JustPressed {
+		state.Active = true
+	}
+	if state.Active && !mouse.AnyButton.Pressed {
+		state.Active = false
+	}
+
+	changed := false
+	if state.Active {
+		newAngle := math.Atan2(float64(mouse.Position.Y-y), float64(mouse.Position.X-x)) * 180 / math.Pi
+		if newAngle < 0 {
+			newAngle += 360
+		}
+		snapping := mouse.Modifiers&EVENT_FLAG_SHIFTKEY != 0
+		if len(snap) > 0 {
+			snapping = snap[0]
+		}
+		if snapping {
+			newAngle = math.Mod(math.Round(newAngle/compassSnapDegrees)*compassSnapDegrees, 360)
+		}
+		if newAngle != *angle {
+			*angle = newAngle
+			changed = true
+		}
+	}
+
+	renderCompass(block, x, y, radius, *angle, status != OUT || state.Active)
+	return changed
+}
+
+// renderCompass draws the compass face, its cardinal ticks and the
+// needle of the current angle.
+func renderCompass(block *Block, x, y, radius int, angle float64, hot bool) {
+	center := Point{x, y}
+	render.circle(block.Where, center, radius, ColorInset, CVUI_FILLED)
+
+	border := ColorBorder
+	if hot {
+		border = theme.CheckboxBorderOver
+	}
+	render.circle(block.Where, center, radius, border, 1)
+
+	for tick := 0.0; tick < 360; tick += 90 {
+		radians := tick * math.Pi / 180
+		outer := Point{x + int(float64(radius)*math.Cos(radians)), y + int(float64(radius)*math.Sin(radians))}
+		inner := Point{x + int(float64(radius-4)*math.Cos(radians)), y + int(float64(radius-4)*math.Sin(radians))}
+		render.line(block.Where, inner, outer, ColorDimText)
+	}
+
+	radians := angle * math.Pi / 180
+	tip := Point{x + int(float64(radius-3)*math.Cos(radians)), y + int(float64(radius-3)*math.Sin(radians))}
+	needle := theme.TextColor
+	if hot {
+		needle = theme.CheckboxCheck
+	}
+	render.line(block.Where, Point{x, y}, tip, needle)
+
+	text := fmt.Sprintf("%.0f", angle)
+	size := render.GetTextSize(text, 0.3)
+	render.putText(block.Where, text, Point{x - size.Width/2, y + radius + size.Height + 6}, 0.3, theme.TextColor)
+}
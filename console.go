@@ -0,0 +1,225 @@
+package gocvui
+
+import (
+	"fmt"
+	"strings"
+
+	"gocv.io/x/gocv"
+)
+
+// LogLevel classifies console entries.
+type LogLevel int
+
+// Console log levels.
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var logLevelColors = map[LogLevel]uint32{
+	LevelDebug: 0x767676,
+	LevelInfo:  0xCECECE,
+	LevelWarn:  0xFFBC00,
+	LevelError: 0xFF4040,
+}
+
+var logLevelTags = []string{"D", "I", "W", "E"}
+
+const consoleToolbarHeight = 24
+
+// ConsoleEntry is one line in a Console.
+type ConsoleEntry struct {
+	Level LogLevel
+	Text  string
+}
+
+// Console is a scrolling log view with per-level filtering, substring
+// search and line selection. The application keeps the instance around
+// and appends entries as they happen.
+type Console struct {
+	Entries []ConsoleEntry
+
+	// MaxEntries caps the backlog; older entries are dropped. Zero
+	// means unbounded.
+	MaxEntries int
+
+	// OnCopy receives the selected line when the copy button is
+	// clicked; highgui has no clipboard access, so the application
+	// decides where the text goes.
+	OnCopy func(text string)
+
+	hidden    [4]bool
+	search    TextInputState
+	searching bool
+	scroll    int
+	paused    bool
+	selected  int
+}
+
+// NewConsole returns an empty console.
+func NewConsole() *Console {
+	return &Console{selected: -1}
+}
+
+// Append adds a formatted entry at the given level.
+func (c *Console) Append(level LogLevel, format string, args ...interface{}) {
+	c.Entries = append(c.Entries, ConsoleEntry{Level: level, Text: fmt.Sprintf(format, args...)})
+	if c.MaxEntries > 0 && len(c.Entries) > c.MaxEntries {
+		drop := len(c.Entries) - c.MaxEntries
+		c.Entries = c.Entries[drop:]
+		c.selected -= drop
+		c.scroll -= drop
+	}
+}
+
+// Render draws the console at (x, y). The toolbar holds the level
+// toggles, the search box and the copy button; scrolling up through the
+// arrows pauses the view until it is scrolled back to the bottom.
+func (c *Console) Render(frame *gocv.Mat, x, y, width, height int) {
+	block := internal.ScreenBlock(frame)
+
+	area := Rect{x, y, width, height}
+	render.rectangle(block.Where, area, 0x1E1E1E, CVUI_FILLED)
+	render.rectangle(block.Where, area, 0x45494A, 1)
+
+	c.renderToolbar(block, x, y, width)
+
+	list := Rect{x, y + consoleToolbarHeight, width - 16, height - consoleToolbarHeight}
+	entries := c.filtered()
+	visible := list.Height / treeRowHeight
+
+	if !c.paused || c.scroll > len(entries)-visible {
+		c.scroll = len(entries) - visible
+	}
+	if c.scroll < 0 {
+		c.scroll = 0
+	}
+
+	c.renderScrollArrows(block, x+width-16, list.Y, list.Height, len(entries), visible)
+
+	rowY := list.Y
+	for i := c.scroll; i < len(entries) && i < c.scroll+visible; i++ {
+		entry := c.Entries[entries[i]]
+		row := Rect{list.X, rowY, list.Width, treeRowHeight}
+
+		if internal.IArea(row.X, row.Y, row.Width, row.Height) == CLICK {
+			c.selected = entries[i]
+		}
+		if entries[i] == c.selected {
+			render.rectangle(block.Where, row, 0x313131, CVUI_FILLED)
+		}
+
+		c.renderEntry(block, row, entry)
+		rowY += treeRowHeight
+	}
+}
+
+func (c *Console) renderToolbar(block *Block, x, y, width int) {
+	toggleX := x + 2
+	for level := LevelDebug; level <= LevelError; level++ {
+		toggle := Rect{toggleX, y + 2, 20, consoleToolbarHeight - 4}
+		if internal.IArea(toggle.X, toggle.Y, toggle.Width, toggle.Height) == CLICK {
+			c.hidden[level] = !c.hidden[level]
+		}
+		fill := uint32(0x383838)
+		if c.hidden[level] {
+			fill = 0x1E1E1E
+		}
+		render.rectangle(block.Where, toggle, fill, CVUI_FILLED)
+		render.rectangle(block.Where, toggle, 0x45494A, 1)
+		size := render.GetTextSize(logLevelTags[level], 0.4)
+		render.putText(block.Where, logLevelTags[level], Point{toggle.X + toggle.Width/2 - size.Width/2, toggle.Y + toggle.Height/2 + size.Height/2}, 0.4, logLevelColors[level])
+		toggleX += 22
+	}
+
+	searchBox := Rect{toggleX + 6, y + 2, width - (toggleX - x) - 60, consoleToolbarHeight - 4}
+	status := internal.IArea(searchBox.X, searchBox.Y, searchBox.Width, searchBox.Height)
+	if status == CLICK {
+		c.searching = true
+	} else if c.searching && internal.GetContext("").Mouse.AnyButton.JustReleased && status == OUT {
+		c.searching = false
+	}
+	if c.searching {
+		if c.search.Feed(internal.LastKeyPressed, false) != EDIT_CONTINUE {
+			c.searching = false
+		}
+		render.EditBox(block, searchBox, c.search.Text)
+	} else {
+		render.rectangle(block.Where, searchBox, 0x292929, CVUI_FILLED)
+		render.rectangle(block.Where, searchBox, 0x45494A, 1)
+		label := c.search.Text
+		if label == "" {
+			label = "search..."
+		}
+		size := render.GetTextSize(label, 0.4)
+		render.putText(block.Where, label, Point{searchBox.X + 4, searchBox.Y + searchBox.Height/2 + size.Height/2}, 0.4, 0x767676)
+	}
+
+	if internal.Button(block, x+width-50, y+2, 48, consoleToolbarHeight-4, "copy", false) {
+		if c.OnCopy != nil && c.selected >= 0 && c.selected < len(c.Entries) {
+			c.OnCopy(c.Entries[c.selected].Text)
+		}
+	}
+}
+
+func (c *Console) renderScrollArrows(block *Block, x, y, height, total, visible int) {
+	up := Rect{x, y, 16, 16}
+	down := Rect{x, y + height - 16, 16, 16}
+
+	if internal.IArea(up.X, up.Y, up.Width, up.Height) == CLICK && c.scroll > 0 {
+		c.scroll--
+		c.paused = true
+	}
+	if internal.IArea(down.X, down.Y, down.Width, down.Height) == CLICK {
+		c.scroll++
+		if c.scroll >= total-visible {
+			c.paused = false
+		}
+	}
+
+	renderDropdownArrow(block, up, true, c.scroll > 0)
+	renderDropdownArrow(block, down, false, c.paused)
+}
+
+// renderEntry draws one line, highlighting search matches.
+func (c *Console) renderEntry(block *Block, row Rect, entry ConsoleEntry) {
+	textY := row.Y + row.Height/2 + render.GetTextSize(entry.Text, 0.4).Height/2
+
+	if c.search.Text != "" {
+		lower := strings.ToLower(entry.Text)
+		needle := strings.ToLower(c.search.Text)
+		from := 0
+		for {
+			i := strings.Index(lower[from:], needle)
+			if i < 0 {
+				break
+			}
+			i += from
+			prefix := render.GetTextSize(entry.Text[:i], 0.4).Width
+			match := render.GetTextSize(entry.Text[i:i+len(needle)], 0.4).Width
+			render.rectangle(block.Where, Rect{row.X + 4 + prefix, row.Y + 2, match, row.Height - 4}, 0x59522A, CVUI_FILLED)
+			from = i + len(needle)
+		}
+	}
+
+	render.putText(block.Where, entry.Text, Point{row.X + 4, textY}, 0.4, logLevelColors[entry.Level])
+}
+
+// filtered returns the indices of entries passing the level filters and
+// the search string.
+func (c *Console) filtered() []int {
+	indices := make([]int, 0, len(c.Entries))
+	needle := strings.ToLower(c.search.Text)
+	for i, entry := range c.Entries {
+		if c.hidden[entry.Level] {
+			continue
+		}
+		if needle != "" && !strings.Contains(strings.ToLower(entry.Text), needle) {
+			continue
+		}
+		indices = append(indices, i)
+	}
+	return indices
+}
@@ -0,0 +1,91 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const contextMenuRowHeight = 20
+
+// contextMenuState keeps a context menu open across frames at the
+// position it was summoned.
+type contextMenuState struct {
+	Open bool
+	X    int
+	Y    int
+}
+
+// ContextMenu watches the rectangle at (x, y) for a right-button click
+// and opens a menu of items at the mouse position. It returns the index
+// of the item selected this frame, or -1. The menu closes on selection,
+// on a click outside of it, or on Esc.
+func ContextMenu(frame *gocv.Mat, x, y, width, height int, items []string) int {
+	state := internal.State(internal.StateID("contextmenu", x, y), func() interface{} {
+		return &contextMenuState{}
+	}).(*contextMenuState)
+
+	mouse := internal.GetContext("").Mouse
+	area := Rect{x, y, width, height}
+
+	if !state.Open {
+		if mouse.Buttons[RIGHT_BUTTON].JustReleased && area.Contains(mouse.Position) {
+			state.Open = true
+			state.X = mouse.Position.X
+			state.Y = mouse.Position.Y
+		}
+		return -1
+	}
+
+	if internal.LastKeyPressed == 27 {
+		state.Open = false
+		return -1
+	}
+
+	menuWidth := 0
+	for _, item := range items {
+		if w := render.GetTextSize(item, 0.4).Width + 20; w > menuWidth {
+			menuWidth = w
+		}
+	}
+	menu := Rect{state.X, state.Y, menuWidth, len(items) * contextMenuRowHeight}
+
+	// The open menu is an overlay: it wins the mouse over whatever
+	// renders underneath it.
+	wasInOverlay := internal.inOverlay
+	internal.inOverlay = true
+	selected := -1
+	for i := range items {
+		row := Rect{menu.X, menu.Y + i*contextMenuRowHeight, menu.Width, contextMenuRowHeight}
+		if internal.IArea(row.X, row.Y, row.Width, row.Height) == CLICK {
+			selected = i
+		}
+	}
+	internal.inOverlay = wasInOverlay
+	if selected >= 0 {
+		state.Open = false
+	} else if mouse.AnyButton.JustReleased && !menu.Contains(mouse.Position) {
+		state.Open = false
+	}
+
+	OverlayPass(menu, func() {
+		renderContextMenu(frame, menu, items)
+	})
+	return selected
+}
+
+// renderContextMenu draws the menu rows as an overlay, highlighting the
+// one under the cursor.
+func renderContextMenu(frame *gocv.Mat, menu Rect, items []string) {
+	block := internal.ScreenBlock(frame)
+	render.rectangle(block.Where, menu, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, menu, ColorBorder, 1)
+
+	mouse := internal.GetContext("").Mouse
+	for i, item := range items {
+		row := Rect{menu.X, menu.Y + i*contextMenuRowHeight, menu.Width, contextMenuRowHeight}
+		if row.Contains(mouse.Position) {
+			render.rectangle(block.Where, row, ColorPanel, CVUI_FILLED)
+		}
+		size := render.GetTextSize(item, 0.4)
+		render.putText(block.Where, item, Point{row.X + 6, row.Y + row.Height/2 + size.Height/2}, 0.4, theme.TextColor)
+	}
+}
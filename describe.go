@@ -0,0 +1,85 @@
+package gocvui
+
+import (
+	"encoding/json"
+)
+
+// WidgetDescription is one widget captured while rendering a frame.
+type WidgetDescription struct {
+	Kind  string `json:"kind"`
+	Label string `json:"label,omitempty"`
+	Value string `json:"value,omitempty"`
+	State string `json:"state,omitempty"`
+	Rect  Rect   `json:"rect"`
+}
+
+// EnableUIDescription toggles per-frame recording of widget
+// descriptions. Recording is off by default to avoid the bookkeeping
+// cost when nothing consumes it.
+func EnableUIDescription(enabled bool) {
+	internal.describing = enabled
+	if !enabled {
+		internal.descriptions = nil
+		internal.lastDescriptions = nil
+	}
+}
+
+// DescribeUI returns a JSON description of the widgets rendered during
+// the last completed frame: their kind, label, current value,
+// interaction state and position. External accessibility tooling or
+// chat-ops bots can consume it to describe and drive the interface.
+func DescribeUI() string {
+	data, err := json.Marshal(internal.lastDescriptions)
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
+
+// LastItemRect returns the rect occupied by the most recently rendered
+// component — Button, Text, Checkbox, Image and the rest all record
+// it — so callers can attach tooltips, draw badges or build custom
+// interactions relative to it:
+//
+//	gocvui.Button(&frame, 10, 10, "Run")
+//	at := gocvui.LastItemRect()
+//	gocvui.Text(&frame, at.X+at.Width+5, at.Y, "<- click me")
+func LastItemRect() Rect {
+	return internal.lastItemRect
+}
+
+// Describe records a widget for the frame's UI description and as the
+// last rendered item (see LastItemRect). The description capture is a
+// no-op unless recording has been enabled via EnableUIDescription.
+func (in *Internal) Describe(kind, label, value string, status int, rect Rect) {
+	in.lastItemRect = rect
+	in.hitRegions = append(in.hitRegions, hitRegion{kind, rect})
+	if in.layoutDebug {
+		in.renderItemDebug(rect)
+	}
+	if !in.describing {
+		return
+	}
+	in.descriptions = append(in.descriptions, WidgetDescription{
+		Kind:  kind,
+		Label: label,
+		Value: value,
+		State: stateName(status),
+		Rect:  rect,
+	})
+}
+
+func stateName(status int) string {
+	switch status {
+	case OVER:
+		return "over"
+	case DOWN:
+		return "down"
+	case CLICK:
+		return "click"
+	case DBLCLICK:
+		return "dblclick"
+	default:
+		return "out"
+	}
+}
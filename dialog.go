@@ -0,0 +1,83 @@
+package gocvui
+
+import (
+	"strings"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	dialogMinWidth     = 220
+	dialogLineHeight   = 16
+	dialogButtonWidth  = 60
+	dialogButtonHeight = 25
+)
+
+// Alert renders a modal message box with an OK button, built on top of
+// BeginModal. Call it every frame while the alert is open; it returns
+// true on the frame the user dismisses it with the button, Enter or
+// Esc, at which point the caller should stop rendering it.
+func Alert(frame *gocv.Mat, title, message string) bool {
+	block, area := beginDialog(frame, title, message, 1)
+
+	ok := internal.Button(block, area.X+area.Width-modalPadding-dialogButtonWidth, area.Y+area.Height-modalPadding-dialogButtonHeight, dialogButtonWidth, dialogButtonHeight, "OK", false)
+	EndModal()
+
+	key := internal.LastKeyPressed
+	return ok || key == 13 || key == 10 || key == 27
+}
+
+// Confirm renders a modal message box with OK and Cancel buttons. It
+// returns true on the frame the user answers it, writing the answer to
+// *result: true for OK or Enter, false for Cancel or Esc. While the
+// dialog is open it returns false and leaves *result untouched.
+func Confirm(frame *gocv.Mat, title, message string, result *bool) bool {
+	block, area := beginDialog(frame, title, message, 2)
+
+	cancelX := area.X + area.Width - modalPadding - dialogButtonWidth
+	okX := cancelX - modalPadding - dialogButtonWidth
+	buttonY := area.Y + area.Height - modalPadding - dialogButtonHeight
+
+	ok := internal.Button(block, okX, buttonY, dialogButtonWidth, dialogButtonHeight, "OK", false)
+	cancel := internal.Button(block, cancelX, buttonY, dialogButtonWidth, dialogButtonHeight, "Cancel", false)
+	EndModal()
+
+	key := internal.LastKeyPressed
+	if ok || key == 13 || key == 10 {
+		*result = true
+		return true
+	}
+	if cancel || key == 27 {
+		*result = false
+		return true
+	}
+	return false
+}
+
+// beginDialog opens a modal sized for message and the button row,
+// renders the message and returns the block and modal area to place
+// the buttons in.
+func beginDialog(frame *gocv.Mat, title, message string, buttons int) (*Block, Rect) {
+	lines := strings.Split(message, "\n")
+
+	width := dialogMinWidth
+	for _, line := range lines {
+		lineWidth := render.GetTextSize(line, 0.4).Width + 2*modalPadding
+		if lineWidth > width {
+			width = lineWidth
+		}
+	}
+	if minWidth := 2*modalPadding + buttons*(dialogButtonWidth+modalPadding); width < minWidth {
+		width = minWidth
+	}
+	height := modalTitleBarHeight + modalPadding + len(lines)*dialogLineHeight + modalPadding + dialogButtonHeight + modalPadding
+
+	BeginModal(frame, title, width, height)
+	block := internal.TopBlock()
+	area := internal.modalRect
+
+	for i, line := range lines {
+		internal.Text(block, area.X+modalPadding, area.Y+modalTitleBarHeight+modalPadding+i*dialogLineHeight, line, 0.4, theme.TextColor, false)
+	}
+	return block, area
+}
@@ -0,0 +1,247 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// DockSide tells where a panel is docked within the frame.
+type DockSide int
+
+const (
+	DockFloating DockSide = iota
+	DockLeft
+	DockRight
+	DockTop
+	DockBottom
+	DockCenter
+)
+
+const (
+	dockSideWidth   = 200
+	dockBandHeight  = 150
+	dockZoneSize    = 40
+	dockZoneMargin  = 10
+	dockFloatWidth  = 200
+	dockFloatHeight = 150
+)
+
+// DockPanel is one panel managed by a Dock. Content is invoked every
+// frame with the area the panel currently occupies, below its title
+// bar.
+type DockPanel struct {
+	Title   string
+	Content func(frame *gocv.Mat, area Rect)
+}
+
+// dockPanelLayout is the serializable placement of one panel: its side
+// and, while floating, its position.
+type dockPanelLayout struct {
+	Side DockSide
+	X    int
+	Y    int
+}
+
+// dockLayout is the serializable layout of a whole Dock, stored in the
+// persistence subsystem so SaveState/LoadState carry it across
+// sessions.
+type dockLayout struct {
+	Panels map[string]*dockPanelLayout
+}
+
+// dockDrag tracks the panel currently being dragged by its title bar.
+type dockDrag struct {
+	Title   string
+	OffsetX int
+	OffsetY int
+}
+
+// Dock manages a set of panels that can float over the frame or be
+// dragged onto edge and center drop zones to dock there, splitting the
+// docked space with the panels already present. The layout is kept in
+// the persistence subsystem under the dock's id.
+type Dock struct {
+	ID     string
+	Panels []*DockPanel
+
+	dragging bool
+	drag     dockDrag
+}
+
+// NewDock returns an empty dock identified by id.
+func NewDock(id string) *Dock {
+	return &Dock{ID: id}
+}
+
+// Add registers a panel with the dock. New panels start floating,
+// cascaded from the top-left corner, unless a persisted layout says
+// otherwise.
+func (d *Dock) Add(title string, content func(frame *gocv.Mat, area Rect)) *DockPanel {
+	panel := &DockPanel{Title: title, Content: content}
+	d.Panels = append(d.Panels, panel)
+	return panel
+}
+
+// layout returns the persistent layout of the dock, creating entries
+// for panels it has not seen yet.
+func (d *Dock) layout() *dockLayout {
+	layout := internal.PersistentState("dock.layout/"+d.ID, func() interface{} {
+		return &dockLayout{Panels: map[string]*dockPanelLayout{}}
+	}).(*dockLayout)
+
+	for i, panel := range d.Panels {
+		if _, ok := layout.Panels[panel.Title]; !ok {
+			layout.Panels[panel.Title] = &dockPanelLayout{
+				Side: DockFloating,
+				X:    40 + i*30,
+				Y:    40 + i*30,
+			}
+		}
+	}
+	return layout
+}
+
+// Render draws all panels of the dock: docked ones first, splitting
+// their side's band, then floating ones on top. Dragging a title bar
+// moves a panel; dropping it on one of the zones shown while dragging
+// docks it there.
+func (d *Dock) Render(frame *gocv.Mat) {
+	layout := d.layout()
+	mouse := internal.GetContext("").Mouse
+
+	bySide := map[DockSide][]*DockPanel{}
+	for _, panel := range d.Panels {
+		bySide[layout.Panels[panel.Title].Side] = append(bySide[layout.Panels[panel.Title].Side], panel)
+	}
+
+	remaining := Rect{0, 0, frame.Cols(), frame.Rows()}
+	if len(bySide[DockLeft]) > 0 {
+		d.renderBand(frame, layout, bySide[DockLeft], Rect{remaining.X, remaining.Y, dockSideWidth, remaining.Height}, true)
+		remaining.X += dockSideWidth
+		remaining.Width -= dockSideWidth
+	}
+	if len(bySide[DockRight]) > 0 {
+		d.renderBand(frame, layout, bySide[DockRight], Rect{remaining.X + remaining.Width - dockSideWidth, remaining.Y, dockSideWidth, remaining.Height}, true)
+		remaining.Width -= dockSideWidth
+	}
+	if len(bySide[DockTop]) > 0 {
+		d.renderBand(frame, layout, bySide[DockTop], Rect{remaining.X, remaining.Y, remaining.Width, dockBandHeight}, false)
+		remaining.Y += dockBandHeight
+		remaining.Height -= dockBandHeight
+	}
+	if len(bySide[DockBottom]) > 0 {
+		d.renderBand(frame, layout, bySide[DockBottom], Rect{remaining.X, remaining.Y + remaining.Height - dockBandHeight, remaining.Width, dockBandHeight}, false)
+		remaining.Height -= dockBandHeight
+	}
+	if len(bySide[DockCenter]) > 0 {
+		d.renderBand(frame, layout, bySide[DockCenter], remaining, false)
+	}
+
+	for _, panel := range bySide[DockFloating] {
+		d.renderFloating(frame, layout, panel)
+	}
+
+	if d.dragging {
+		zone := d.renderDropZones(frame)
+		if mouse.AnyButton.JustReleased {
+			if zone != DockFloating {
+				layout.Panels[d.drag.Title].Side = zone
+			}
+			d.dragging = false
+		}
+	}
+}
+
+// renderBand splits area among panels, stacking them vertically when
+// vertical is true and side by side otherwise.
+func (d *Dock) renderBand(frame *gocv.Mat, layout *dockLayout, panels []*DockPanel, area Rect, vertical bool) {
+	for i, panel := range panels {
+		slot := area
+		if vertical {
+			slot.Height = area.Height / len(panels)
+			slot.Y = area.Y + i*slot.Height
+		} else {
+			slot.Width = area.Width / len(panels)
+			slot.X = area.X + i*slot.Width
+		}
+		d.renderPanel(frame, layout, panel, slot)
+	}
+}
+
+// renderFloating draws panel at its floating position, following the
+// mouse while its title bar is being dragged.
+func (d *Dock) renderFloating(frame *gocv.Mat, layout *dockLayout, panel *DockPanel) {
+	mouse := internal.GetContext("").Mouse
+	placement := layout.Panels[panel.Title]
+
+	if d.dragging && d.drag.Title == panel.Title {
+		placement.X = mouse.Position.X - d.drag.OffsetX
+		placement.Y = mouse.Position.Y - d.drag.OffsetY
+	}
+	d.renderPanel(frame, layout, panel, Rect{placement.X, placement.Y, dockFloatWidth, dockFloatHeight})
+}
+
+// renderPanel draws the title bar and content of panel within slot and
+// starts a drag when its title bar is grabbed.
+func (d *Dock) renderPanel(frame *gocv.Mat, layout *dockLayout, panel *DockPanel, slot Rect) {
+	mouse := internal.GetContext("").Mouse
+	block := internal.ScreenBlock(frame)
+	placement := layout.Panels[panel.Title]
+
+	titleBar := Rect{slot.X, slot.Y, slot.Width, chromeTitleBarHeight}
+	barColor := theme.TitleBarInactive
+	if d.dragging && d.drag.Title == panel.Title {
+		barColor = theme.TitleBarActive
+	}
+	render.rectangle(block.Where, titleBar, barColor, CVUI_FILLED)
+	render.rectangle(block.Where, titleBar, ColorOutline, 1)
+	size := render.GetTextSize(panel.Title, 0.4)
+	render.putText(block.Where, panel.Title, Point{titleBar.X + 5, titleBar.Y + size.Height + (titleBar.Height-size.Height)/2 - 1}, 0.4, theme.TextColor)
+
+	content := Rect{slot.X, slot.Y + chromeTitleBarHeight, slot.Width, slot.Height - chromeTitleBarHeight}
+	render.rectangle(block.Where, content, ColorPanel, CVUI_FILLED)
+	render.rectangle(block.Where, content, ColorOutline, 1)
+	if panel.Content != nil {
+		panel.Content(frame, content)
+	}
+
+	if !d.dragging && mouse.AnyButton.JustPressed && titleBar.Contains(mouse.Position) {
+		d.dragging = true
+		d.drag = dockDrag{
+			Title:   panel.Title,
+			OffsetX: mouse.Position.X - slot.X,
+			OffsetY: mouse.Position.Y - slot.Y,
+		}
+		if placement.Side != DockFloating {
+			placement.Side = DockFloating
+			placement.X = slot.X
+			placement.Y = slot.Y
+		}
+	}
+}
+
+// renderDropZones draws the edge and center drop zones while a panel
+// is being dragged and returns the zone under the cursor, if any.
+func (d *Dock) renderDropZones(frame *gocv.Mat) DockSide {
+	mouse := internal.GetContext("").Mouse
+	block := internal.ScreenBlock(frame)
+
+	zones := map[DockSide]Rect{
+		DockLeft:   {dockZoneMargin, (frame.Rows() - dockZoneSize) / 2, dockZoneSize, dockZoneSize},
+		DockRight:  {frame.Cols() - dockZoneMargin - dockZoneSize, (frame.Rows() - dockZoneSize) / 2, dockZoneSize, dockZoneSize},
+		DockTop:    {(frame.Cols() - dockZoneSize) / 2, dockZoneMargin, dockZoneSize, dockZoneSize},
+		DockBottom: {(frame.Cols() - dockZoneSize) / 2, frame.Rows() - dockZoneMargin - dockZoneSize, dockZoneSize, dockZoneSize},
+		DockCenter: {(frame.Cols() - dockZoneSize) / 2, (frame.Rows() - dockZoneSize) / 2, dockZoneSize, dockZoneSize},
+	}
+
+	hovered := DockFloating
+	for side, zone := range zones {
+		hexColor := ColorBorder
+		if zone.Contains(mouse.Position) {
+			hexColor = theme.CheckboxCheck
+			hovered = side
+		}
+		render.rectangle(block.Where, zone, ColorPanel, CVUI_FILLED)
+		render.rectangle(block.Where, zone, hexColor, 1)
+	}
+	return hovered
+}
@@ -0,0 +1,167 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	dropdownBoxHeight  = 22
+	dropdownRowHeight  = 20
+	dropdownMaxVisible = 8
+	dropdownArrowBand  = 14
+)
+
+// dropdownState keeps the cross-frame state of a Dropdown.
+type dropdownState struct {
+	Open   bool
+	Scroll int
+}
+
+// Dropdown renders a combo box showing the item at *selected. Clicking
+// it opens a list of all items, drawn on top of any component rendered
+// afterwards; long lists can be scrolled through the arrow bands at the
+// edges of the list. Returns true when the selection changes.
+func Dropdown(frame *gocv.Mat, x, y, width int, items []string, selected *int) bool {
+	state := internal.State(internal.StateID("dropdown", x, y), func() interface{} {
+		return &dropdownState{}
+	}).(*dropdownState)
+
+	block := internal.ScreenBlock(frame)
+	box := Rect{x, y, width, dropdownBoxHeight}
+	status := internal.IArea(box.X, box.Y, box.Width, box.Height)
+
+	if status == CLICK {
+		state.Open = !state.Open
+	}
+
+	changed := false
+	visible := len(items)
+	if visible > dropdownMaxVisible {
+		visible = dropdownMaxVisible
+	}
+	scrollable := len(items) > dropdownMaxVisible
+
+	list := Rect{x, y + box.Height, width, visible * dropdownRowHeight}
+	if scrollable {
+		list.Height += 2 * dropdownArrowBand
+	}
+
+	if state.Open {
+		// The open list is an overlay: it wins the mouse over whatever
+		// renders underneath it.
+		wasInOverlay := internal.inOverlay
+		internal.inOverlay = true
+
+		rowsY := list.Y
+		if scrollable {
+			if internal.IArea(list.X, list.Y, list.Width, dropdownArrowBand) == CLICK && state.Scroll > 0 {
+				state.Scroll--
+			}
+			if internal.IArea(list.X, list.Y+list.Height-dropdownArrowBand, list.Width, dropdownArrowBand) == CLICK && state.Scroll < len(items)-visible {
+				state.Scroll++
+			}
+			rowsY += dropdownArrowBand
+		}
+
+		hovered := -1
+		for i := 0; i < visible; i++ {
+			row := Rect{list.X, rowsY + i*dropdownRowHeight, list.Width, dropdownRowHeight}
+			rowStatus := internal.IArea(row.X, row.Y, row.Width, row.Height)
+			if rowStatus == OVER || rowStatus == DOWN {
+				hovered = i
+			}
+			if rowStatus == CLICK {
+				index := state.Scroll + i
+				if index != *selected {
+					*selected = index
+					changed = true
+				}
+				state.Open = false
+			}
+		}
+
+		// A click anywhere else closes the list.
+		mouse := &internal.GetContext("").Mouse
+		if mouse.AnyButton.JustReleased && !mouse.Position.Inside(box) && !mouse.Position.Inside(list) {
+			state.Open = false
+		}
+
+		internal.inOverlay = wasInOverlay
+
+		open := *state
+		selectedNow := *selected
+		OverlayPass(list, func() {
+			renderDropdownList(block, list, items, open, visible, scrollable, hovered, selectedNow)
+		})
+	}
+
+	renderDropdownBox(block, box, status, items, *selected)
+	return changed
+}
+
+func renderDropdownBox(block *Block, box Rect, status int, items []string, selected int) {
+	fill := uint32(0x292929)
+	if status == OVER || status == DOWN {
+		fill = 0x333333
+	}
+	render.rectangle(block.Where, box, fill, CVUI_FILLED)
+	render.rectangle(block.Where, box, 0x45494A, 1)
+
+	label := ""
+	if selected >= 0 && selected < len(items) {
+		label = items[selected]
+	}
+	size := render.GetTextSize(label, 0.4)
+	render.putText(block.Where, label, Point{box.X + 6, box.Y + box.Height/2 + size.Height/2}, 0.4, 0xCECECE)
+
+	// The little arrow on the right side of the box.
+	arrowX := box.X + box.Width - 14
+	arrowY := box.Y + box.Height/2 - 2
+	render.line(block.Where, Point{arrowX, arrowY}, Point{arrowX + 4, arrowY + 4}, 0xCECECE)
+	render.line(block.Where, Point{arrowX + 4, arrowY + 4}, Point{arrowX + 8, arrowY}, 0xCECECE)
+}
+
+func renderDropdownList(block *Block, list Rect, items []string, state dropdownState, visible int, scrollable bool, hovered, selected int) {
+	render.rectangle(block.Where, list, 0x292929, CVUI_FILLED)
+	render.rectangle(block.Where, list, 0x45494A, 1)
+
+	rowsY := list.Y
+	if scrollable {
+		renderDropdownArrow(block, Rect{list.X, list.Y, list.Width, dropdownArrowBand}, true, state.Scroll > 0)
+		renderDropdownArrow(block, Rect{list.X, list.Y + list.Height - dropdownArrowBand, list.Width, dropdownArrowBand}, false, state.Scroll < len(items)-visible)
+		rowsY += dropdownArrowBand
+	}
+
+	for i := 0; i < visible; i++ {
+		index := state.Scroll + i
+		if index >= len(items) {
+			break
+		}
+		row := Rect{list.X, rowsY + i*dropdownRowHeight, list.Width, dropdownRowHeight}
+		if i == hovered {
+			render.rectangle(block.Where, row, 0x3E4348, CVUI_FILLED)
+		}
+		hexColor := uint32(0xCECECE)
+		if index == selected {
+			hexColor = 0xFFBC00
+		}
+		size := render.GetTextSize(items[index], 0.4)
+		render.putText(block.Where, items[index], Point{row.X + 6, row.Y + row.Height/2 + size.Height/2}, 0.4, hexColor)
+	}
+}
+
+func renderDropdownArrow(block *Block, band Rect, up bool, enabled bool) {
+	hexColor := uint32(0x767676)
+	if enabled {
+		hexColor = 0xCECECE
+	}
+	midX := band.X + band.Width/2
+	midY := band.Y + band.Height/2
+	if up {
+		render.line(block.Where, Point{midX - 4, midY + 2}, Point{midX, midY - 2}, hexColor)
+		render.line(block.Where, Point{midX, midY - 2}, Point{midX + 4, midY + 2}, hexColor)
+	} else {
+		render.line(block.Where, Point{midX - 4, midY - 2}, Point{midX, midY + 2}, hexColor)
+		render.line(block.Where, Point{midX, midY + 2}, Point{midX + 4, midY - 2}, hexColor)
+	}
+}
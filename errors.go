@@ -0,0 +1,79 @@
+package gocvui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gocv.io/x/gocv"
+)
+
+// Sentinel errors reported on library misuse. Match them with
+// errors.Is after installing an error handler via SetErrorHandler.
+var (
+	// ErrNoContext means no window context was available; Init() or
+	// Watch() was probably not called.
+	ErrNoContext = errors.New("gocvui: no context available")
+	// ErrUnknownWindow means a window name was used that Init()/Watch()
+	// never saw.
+	ErrUnknownWindow = errors.New("gocvui: unknown window")
+	// ErrStackMismatch means begin*() and end*() calls are not
+	// balanced around the failing call.
+	ErrStackMismatch = errors.New("gocvui: begin/end block mismatch")
+	// ErrInvalidButton means a mouse query used an invalid button.
+	ErrInvalidButton = errors.New("gocvui: invalid mouse button")
+	// ErrBadArgument means a variadic component call received an
+	// argument of an unsupported type.
+	ErrBadArgument = errors.New("gocvui: bad argument")
+)
+
+// UIError carries the window and widget where a library error happened.
+// It wraps one of the sentinel errors above.
+type UIError struct {
+	Err    error
+	Window string
+	Widget string
+	Detail string
+}
+
+// Error implements the error interface.
+func (e *UIError) Error() string {
+	message := e.Err.Error()
+	if e.Window != "" {
+		message += " (window \"" + e.Window + "\"" + ")"
+	}
+	if e.Widget != "" {
+		message += " [" + e.Widget + "]"
+	}
+	if e.Detail != "" {
+		message += ": " + e.Detail
+	}
+	return message
+}
+
+// Unwrap exposes the sentinel for errors.Is/errors.As.
+func (e *UIError) Unwrap() error {
+	return e.Err
+}
+
+// SetErrorHandler installs fn as the handler for library usage errors.
+// The default handler prints the error and aborts the program after
+// giving the user a chance to read it; an application handler may
+// instead log and carry on, in which case the failing call returns a
+// zero value.
+func SetErrorHandler(fn func(error)) {
+	internal.errorHandler = fn
+}
+
+// Fail reports a library usage error through the installed handler, or
+// the default print-and-abort behavior when none is set.
+func (in *Internal) Fail(sentinel error, widget, detail string) {
+	err := &UIError{Err: sentinel, Window: in.CurrentContext, Widget: widget, Detail: detail}
+	if in.errorHandler != nil {
+		in.errorHandler(err)
+		return
+	}
+	fmt.Printf("[CVUI] Fatal error: %v\n", err)
+	gocv.WaitKey(100000)
+	os.Exit(-1)
+}
@@ -0,0 +1,161 @@
+package gocvui
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	fileDialogWidth     = 380
+	fileDialogHeight    = 320
+	fileDialogRowHeight = 18
+	fileDialogRows      = 11
+)
+
+// fileDialogState keeps the browsed directory, selection and filter of
+// the file dialog between frames.
+type fileDialogState struct {
+	Dir      string
+	Selected string
+	Scroll   int
+	Filter   TextInputState
+}
+
+// OpenFileDialog renders a modal file browser drawn entirely with
+// gocvui widgets: a path bar, a directory list and a filename filter.
+// highgui has no native dialogs, so this is the way for demo apps to
+// pick files in-canvas. Call it every frame while the dialog should be
+// shown; it returns (path, true) on the frame a file is chosen and
+// ("", true) when the dialog is canceled with Esc.
+func OpenFileDialog(frame *gocv.Mat, startDir string) (string, bool) {
+	state := internal.State(internal.StateID("filedialog", 0, 0), func() interface{} {
+		return &fileDialogState{Dir: startDir}
+	}).(*fileDialogState)
+
+	BeginModal(frame, "Open file", fileDialogWidth, fileDialogHeight)
+	defer EndModal()
+	block := internal.TopBlock()
+	area := internal.modalRect
+
+	switch state.Filter.Feed(internal.LastKeyPressed, false) {
+	case EDIT_CANCEL:
+		*state = fileDialogState{Dir: startDir}
+		return "", true
+	case EDIT_COMMIT:
+		if state.Selected != "" {
+			chosen := filepath.Join(state.Dir, state.Selected)
+			*state = fileDialogState{Dir: state.Dir}
+			return chosen, true
+		}
+	}
+
+	pathBar := state.Dir
+	for render.GetTextSize(pathBar, 0.4).Width > fileDialogWidth-2*modalPadding && len(pathBar) > 4 {
+		pathBar = "..." + pathBar[4:]
+	}
+	internal.Text(block, area.X+modalPadding, area.Y+modalTitleBarHeight+6, pathBar, 0.4, ColorDimText, false)
+
+	listArea := Rect{area.X + modalPadding, area.Y + modalTitleBarHeight + 24, fileDialogWidth - 2*modalPadding, fileDialogRows * fileDialogRowHeight}
+	chosen, done := renderFileDialogList(block, listArea, state)
+
+	filterBox := Rect{listArea.X, listArea.Y + listArea.Height + 6, listArea.Width, 20}
+	render.EditBox(block, filterBox, state.Filter.Text)
+
+	if done {
+		*state = fileDialogState{Dir: state.Dir}
+		return chosen, true
+	}
+	return "", false
+}
+
+// fileDialogEntries lists the filtered content of dir, directories
+// first, with a ".." entry to go up.
+func fileDialogEntries(dir, filter string) []string {
+	var dirs, files []string
+	entries, err := os.ReadDir(dir)
+	if err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				dirs = append(dirs, entry.Name()+"/")
+			} else if filter == "" || strings.Contains(strings.ToLower(entry.Name()), strings.ToLower(filter)) {
+				files = append(files, entry.Name())
+			}
+		}
+	}
+	sort.Strings(dirs)
+	sort.Strings(files)
+
+	all := []string{"../"}
+	all = append(all, dirs...)
+	return append(all, files...)
+}
+
+// renderFileDialogList draws the scrollable entry list. Clicking a
+// directory navigates into it; clicking a file selects it and a second
+// click (or Enter) chooses it.
+func renderFileDialogList(block *Block, area Rect, state *fileDialogState) (string, bool) {
+	entries := fileDialogEntries(state.Dir, state.Filter.Text)
+
+	visible := area.Height / fileDialogRowHeight
+	rowsY := area.Y
+
+	render.rectangle(block.Where, area, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorBorder, 1)
+
+	if len(entries) > visible {
+		visible = (area.Height - 2*dropdownArrowBand) / fileDialogRowHeight
+		if internal.IArea(area.X, area.Y, area.Width, dropdownArrowBand) == CLICK && state.Scroll > 0 {
+			state.Scroll--
+		}
+		if internal.IArea(area.X, area.Y+area.Height-dropdownArrowBand, area.Width, dropdownArrowBand) == CLICK && state.Scroll < len(entries)-visible {
+			state.Scroll++
+		}
+		renderDropdownArrow(block, Rect{area.X, area.Y, area.Width, dropdownArrowBand}, true, state.Scroll > 0)
+		renderDropdownArrow(block, Rect{area.X, area.Y + area.Height - dropdownArrowBand, area.Width, dropdownArrowBand}, false, state.Scroll < len(entries)-visible)
+		rowsY += dropdownArrowBand
+	}
+	if state.Scroll > len(entries)-visible {
+		state.Scroll = maxInt(len(entries)-visible, 0)
+	}
+
+	for i := 0; i < visible && state.Scroll+i < len(entries); i++ {
+		entry := entries[state.Scroll+i]
+		row := Rect{area.X, rowsY + i*fileDialogRowHeight, area.Width, fileDialogRowHeight}
+		status := internal.IArea(row.X, row.Y, row.Width, row.Height)
+
+		if status == OVER || status == DOWN {
+			render.rectangle(block.Where, row, ColorPanel, CVUI_FILLED)
+		} else if entry == state.Selected {
+			render.rectangle(block.Where, row, ColorPanel, CVUI_FILLED)
+			render.rectangle(block.Where, row, ColorBorder, 1)
+		}
+
+		hexColor := theme.TextColor
+		if strings.HasSuffix(entry, "/") {
+			hexColor = theme.CheckboxCheck
+		}
+		size := render.GetTextSize(entry, 0.4)
+		render.putText(block.Where, entry, Point{row.X + 6, row.Y + row.Height/2 + size.Height/2}, 0.4, hexColor)
+
+		if status == CLICK {
+			if entry == "../" {
+				state.Dir = filepath.Dir(state.Dir)
+				state.Scroll = 0
+				state.Selected = ""
+			} else if strings.HasSuffix(entry, "/") {
+				state.Dir = filepath.Join(state.Dir, strings.TrimSuffix(entry, "/"))
+				state.Scroll = 0
+				state.Selected = ""
+			} else if entry == state.Selected {
+				return filepath.Join(state.Dir, entry), true
+			} else {
+				state.Selected = entry
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,96 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	formLabelGap = 10
+	formRowGap   = 6
+)
+
+// formState carries the label column measurement of a form across
+// frames: labels are measured as rows render, and the widest one of the
+// previous frame — the only complete measurement an immediate-mode pass
+// has — aligns the control column, so a label change settles within one
+// frame.
+type formState struct {
+	frame          int
+	labelWidth     int
+	lastLabelWidth int
+}
+
+// formScopes tracks the open forms, innermost last.
+var formScopes []*formState
+
+// BeginForm starts a two-column settings form at (x, y): FormRow calls
+// between BeginForm and EndForm render their labels left-aligned in a
+// shared column and their controls in a second column next to it, with
+// no manual alignment math:
+//
+//	gocvui.BeginForm(&frame, 10, 10, 250)
+//	gocvui.FormRow("Threshold", func() { gocvui.Trackbar(120, &value, params) })
+//	gocvui.FormRow("Enabled", func() { gocvui.Checkbox("", &enabled) })
+//	gocvui.EndForm()
+func BeginForm(frame *gocv.Mat, x, y, width int) {
+	internal.BeginBlock(frame, x, y, width, -1, formRowGap, COLUMN)
+
+	state := internal.State(internal.StateID("form", x, y), func() interface{} {
+		return &formState{frame: -1}
+	}).(*formState)
+	if state.frame != internal.Clock.Frame() {
+		state.frame = internal.Clock.Frame()
+		state.lastLabelWidth = state.labelWidth
+		state.labelWidth = 0
+	}
+	formScopes = append(formScopes, state)
+}
+
+// FormRow renders one row of the enclosing form: the label in the
+// shared left column and whatever widget draws in the right one. The
+// widget function renders into a row block, so several controls flow
+// side by side.
+func FormRow(label string, widget func()) {
+	if len(formScopes) == 0 {
+		internal.WarnOnce("formrow-no-form", "FormRow() outside a BeginForm()/EndForm() pair has no effect")
+		return
+	}
+	state := formScopes[len(formScopes)-1]
+	column := internal.TopBlock()
+
+	size := render.GetTextSize(label, 0.4)
+	if size.Width > state.labelWidth {
+		state.labelWidth = size.Width
+	}
+	labelColumn := state.lastLabelWidth
+	if labelColumn == 0 {
+		labelColumn = size.Width
+	}
+
+	x, y := column.Anchor.X, column.Anchor.Y
+	internal.BeginBlock(column.Where, x+labelColumn+formLabelGap, y, -1, -1, 4, ROW)
+	widget()
+
+	depth := internal.StackCount
+	row := internal.PopBlock()
+	internal.finishStretches(depth, row)
+	if internal.layoutDebug {
+		internal.renderLayoutDebug(row)
+	}
+
+	rowHeight := row.Fill.Height
+	if size.Height > rowHeight {
+		rowHeight = size.Height
+	}
+	render.putText(column.Where, label, Point{x, y + (rowHeight+size.Height)/2}, 0.4, ColorText)
+
+	internal.UpdateLayoutFlow(column, Size{labelColumn + formLabelGap + row.Fill.Width, rowHeight})
+}
+
+// EndForm closes the block opened by BeginForm.
+func EndForm() {
+	if len(formScopes) > 0 {
+		formScopes = formScopes[:len(formScopes)-1]
+	}
+	internal.EndBlock()
+}
@@ -0,0 +1,102 @@
+package gocvui
+
+// FractionWidth resolves a fraction of the inner width of the current
+// begin*/end* block into pixels, so children can be sized relative to
+// the parent instead of hard-coding pixel values:
+//
+//	gocvui.BeginRow(&frame, 10, 10, 300, 30)
+//	gocvui.Trackbar(gocvui.FractionWidth(0.3), &value, params)
+//	gocvui.EndRow()
+//
+// Outside a block it warns and returns 0.
+func FractionWidth(fraction float64) int {
+	return internal.fractionOf(fraction, true)
+}
+
+// FractionHeight resolves a fraction of the inner height of the
+// current begin*/end* block into pixels. Outside a block it warns and
+// returns 0.
+func FractionHeight(fraction float64) int {
+	return internal.fractionOf(fraction, false)
+}
+
+// ColumnWeights splits the width of the current row block into one
+// pixel width per weight, distributed proportionally with the block's
+// padding between columns already accounted for. Children sized with
+// the returned widths line up into table-like columns that follow the
+// row width:
+//
+//	gocvui.BeginRow(&frame, 10, 10, 300, 30)
+//	widths := gocvui.ColumnWeights(2, 1, 1)
+//	gocvui.Trackbar(widths[0], &value, params)
+//	gocvui.FixedSize(widths[1], 0)
+//	gocvui.Button("Apply")
+//	gocvui.FixedSize(widths[2], 0)
+//	gocvui.Button("Reset")
+//	gocvui.EndRow()
+//
+// Outside a block it warns and returns zero widths.
+func ColumnWeights(weights ...float64) []int {
+	widths := make([]int, len(weights))
+	if len(weights) == 0 {
+		return widths
+	}
+
+	block := internal.TopBlock()
+	if block == internal.Screen {
+		internal.WarnOnce("weights-no-block", "ColumnWeights() outside a begin*/end* block has no effect")
+		return widths
+	}
+
+	total := 0.0
+	for _, weight := range weights {
+		if weight > 0 {
+			total += weight
+		}
+	}
+	available := block.Rect.Width - len(weights)*block.Padding
+	if total <= 0 || available <= 0 {
+		return widths
+	}
+
+	used := 0
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		if i == len(weights)-1 {
+			// The last column absorbs the rounding remainder so the
+			// total matches the row width exactly.
+			widths[i] = available - used
+		} else {
+			widths[i] = int(float64(available) * weight / total)
+		}
+		used += widths[i]
+	}
+	return widths
+}
+
+// fractionOf measures a fraction of the content area of the top block
+// along one axis, i.e. the block size minus its padding on both sides.
+func (in *Internal) fractionOf(fraction float64, horizontal bool) int {
+	block := in.TopBlock()
+	if block == in.Screen {
+		in.WarnOnce("fraction-no-block", "FractionWidth()/FractionHeight() outside a begin*/end* block has no effect")
+		return 0
+	}
+	if fraction < 0 {
+		fraction = 0
+	} else if fraction > 1 {
+		fraction = 1
+	}
+
+	axis := block.Rect.Width
+	if !horizontal {
+		axis = block.Rect.Height
+	}
+	inner := axis - 2*block.Padding
+	if inner < 0 {
+		inner = 0
+	}
+	return int(float64(inner) * fraction)
+}
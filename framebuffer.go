@@ -0,0 +1,46 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// FrameBuffer manages two reusable frame Mats so render loops stop
+// allocating a fresh NewMat every iteration. Drawing always happens on
+// the back buffer returned by Begin while the front buffer is the one
+// last shown, so a window never displays a half-drawn frame.
+type FrameBuffer struct {
+	buffers [2]gocv.Mat
+	back    int
+}
+
+// Frames returns a double buffer of frames sized width x height, of
+// the BGR type used throughout the library.
+func Frames(width, height int) *FrameBuffer {
+	return &FrameBuffer{
+		buffers: [2]gocv.Mat{
+			gocv.NewMatWithSize(height, width, gocv.MatTypeCV8UC3),
+			gocv.NewMatWithSize(height, width, gocv.MatTypeCV8UC3),
+		},
+	}
+}
+
+// Begin returns the back buffer to draw the next frame on. Its content
+// is whatever was drawn two frames ago; callers normally clear or
+// overwrite it entirely.
+func (f *FrameBuffer) Begin() *gocv.Mat {
+	return &f.buffers[f.back]
+}
+
+// Present updates the UI state of windowName and shows the back buffer
+// in it, then swaps the buffers. It is the double-buffered equivalent
+// of Imshow.
+func (f *FrameBuffer) Present(windowName string) {
+	Imshow(windowName, &f.buffers[f.back])
+	f.back = 1 - f.back
+}
+
+// Close releases both buffers.
+func (f *FrameBuffer) Close() {
+	f.buffers[0].Close()
+	f.buffers[1].Close()
+}
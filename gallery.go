@@ -0,0 +1,113 @@
+package gocvui
+
+import (
+	"image"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	galleryPadding   = 4
+	galleryArrowBand = 14
+)
+
+// galleryState keeps the scroll position of a Gallery between frames.
+type galleryState struct {
+	Scroll int
+}
+
+// Gallery renders images as a horizontally scrollable strip of
+// thumbnails bound to the index at *selected — for picking among
+// reference frames or batch results. Thumbnails are scaled to the
+// strip height preserving aspect ratio; clicking one selects it and
+// the side bands scroll when the strip overflows. Returns true when
+// the selection changes.
+func Gallery(frame *gocv.Mat, x, y, width, height int, images []gocv.Mat, selected *int) bool {
+	block := internal.ScreenBlock(frame)
+	area := Rect{x, y, width, height}
+
+	state := internal.State(internal.StateID("gallery", x, y), func() interface{} {
+		return &galleryState{}
+	}).(*galleryState)
+
+	render.rectangle(block.Where, area, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorBorder, 1)
+
+	if state.Scroll > len(images)-1 {
+		state.Scroll = maxInt(len(images)-1, 0)
+	}
+
+	thumbHeight := height - 2*galleryPadding
+	stripX := x + galleryArrowBand + galleryPadding
+	stripEnd := x + width - galleryArrowBand - galleryPadding
+
+	changed := false
+	drawn := 0
+	thumbX := stripX
+	for index := state.Scroll; index < len(images); index++ {
+		img := images[index]
+		if img.Empty() || img.Rows() == 0 {
+			continue
+		}
+		thumbWidth := thumbHeight * img.Cols() / img.Rows()
+		if thumbX+thumbWidth > stripEnd {
+			break
+		}
+
+		thumb := Rect{thumbX, y + galleryPadding, thumbWidth, thumbHeight}
+		renderThumbnail(block, thumb, img)
+
+		status := internal.IArea(thumb.X, thumb.Y, thumb.Width, thumb.Height)
+		if status == CLICK && *selected != index {
+			*selected = index
+			changed = true
+		}
+		if index == *selected {
+			render.rectangle(block.Where, thumb, theme.CheckboxCheck, 1)
+		} else if status == OVER || status == DOWN {
+			render.rectangle(block.Where, thumb, theme.CheckboxBorderOver, 1)
+		}
+
+		thumbX += thumbWidth + galleryPadding
+		drawn++
+	}
+
+	canScrollLeft := state.Scroll > 0
+	canScrollRight := state.Scroll+drawn < len(images)
+	if internal.IArea(x, y, galleryArrowBand, height) == CLICK && canScrollLeft {
+		state.Scroll--
+	}
+	if internal.IArea(x+width-galleryArrowBand, y, galleryArrowBand, height) == CLICK && canScrollRight {
+		state.Scroll++
+	}
+	renderGalleryArrow(block, Rect{x, y, galleryArrowBand, height}, true, canScrollLeft)
+	renderGalleryArrow(block, Rect{x + width - galleryArrowBand, y, galleryArrowBand, height}, false, canScrollRight)
+
+	return changed
+}
+
+// renderThumbnail scales img into rect.
+func renderThumbnail(block *Block, rect Rect, img gocv.Mat) {
+	thumb := gocv.NewMat()
+	gocv.Resize(img, &thumb, image.Pt(rect.Width, rect.Height), 0, 0, gocv.InterpolationArea)
+	render.Image(block, rect, thumb)
+	thumb.Close()
+}
+
+// renderGalleryArrow draws a left or right scroll band.
+func renderGalleryArrow(block *Block, band Rect, left, enabled bool) {
+	hexColor := ColorBorder
+	if enabled {
+		hexColor = theme.TextColor
+	}
+	centerY := band.Y + band.Height/2
+	if left {
+		tip := Point{band.X + 4, centerY}
+		render.line(block.Where, Point{tip.X + 5, centerY - 5}, tip, hexColor)
+		render.line(block.Where, tip, Point{tip.X + 5, centerY + 5}, hexColor)
+	} else {
+		tip := Point{band.X + band.Width - 4, centerY}
+		render.line(block.Where, Point{tip.X - 5, centerY - 5}, tip, hexColor)
+		render.line(block.Where, tip, Point{tip.X - 5, centerY + 5}, hexColor)
+	}
+}
@@ -0,0 +1,66 @@
+package gocvui
+
+import (
+	"fmt"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+// GaugeZone colors the span of a gauge between From and To, e.g. an
+// amber warning band and a red critical band.
+type GaugeZone struct {
+	From  float64
+	To    float64
+	Color uint32
+}
+
+// Gauge renders a semicircular dial with a needle pointing at value
+// within the min..max range — a complement to Sparkline for showing
+// instantaneous metrics like FPS, latency or temperature. The dial is
+// centered at (x, y) and spans the half circle above it. Optional
+// zones color parts of the arc.
+func Gauge(frame *gocv.Mat, x, y, radius int, value, min, max float64, zones ...GaugeZone) {
+	block := internal.ScreenBlock(frame)
+	span := max - min
+	if span <= 0 {
+		internal.WarnOnce(internal.StateID("gauge.range", x, y), "gauge has a degenerate range", "min", min, "max", max)
+		span = 1
+	}
+
+	// The arc runs counterclockwise from the left (min) to the right
+	// (max), drawn as one short segment per degree.
+	for degree := 0; degree < 180; degree++ {
+		ratio := float64(degree) / 180
+		hexColor := ColorBorder
+		for _, zone := range zones {
+			if v := min + ratio*span; v >= zone.From && v < zone.To {
+				hexColor = zone.Color
+			}
+		}
+		radians := math.Pi + ratio*math.Pi
+		inner := Point{x + int(float64(radius-4)*math.Cos(radians)), y + int(float64(radius-4)*math.Sin(radians))}
+		outer := Point{x + int(float64(radius)*math.Cos(radians)), y + int(float64(radius)*math.Sin(radians))}
+		render.line(block.Where, inner, outer, hexColor)
+	}
+
+	ratio := (value - min) / span
+	if ratio < 0 {
+		ratio = 0
+	}
+	if ratio > 1 {
+		ratio = 1
+	}
+	radians := math.Pi + ratio*math.Pi
+	tip := Point{x + int(float64(radius-6)*math.Cos(radians)), y + int(float64(radius-6)*math.Sin(radians))}
+	render.line(block.Where, Point{x, y}, tip, theme.CheckboxCheck)
+
+	text := fmt.Sprintf("%g", value)
+	size := render.GetTextSize(text, 0.4)
+	render.putText(block.Where, text, Point{x - size.Width/2, y + size.Height + 6}, 0.4, theme.TextColor)
+
+	minText := fmt.Sprintf("%g", min)
+	maxText := fmt.Sprintf("%g", max)
+	render.putText(block.Where, minText, Point{x - radius, y + 12}, 0.3, ColorDimText)
+	render.putText(block.Where, maxText, Point{x + radius - render.GetTextSize(maxText, 0.3).Width, y + 12}, 0.3, ColorDimText)
+}
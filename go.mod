@@ -0,0 +1,7 @@
+module github.com/waitxxxx/gocvui
+
+go 1.21
+
+toolchain go1.21.6
+
+require gocv.io/x/gocv v0.43.0
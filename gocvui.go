@@ -0,0 +1,646 @@
+// Package gocvui is a (very) simple UI library built on top of OpenCV
+// drawing primitives, in the spirit of cvui. Other UI libraries require
+// a full graphical toolkit; gocvui needs nothing but gocv and a window
+// to render immediate-mode buttons, checkboxes, trackbars and friends
+// directly onto your frames.
+package gocvui
+
+import (
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+// VERSION is the current version of the library.
+const VERSION = "1.0.0"
+
+// Layout flow directions.
+const (
+	ROW    = 0
+	COLUMN = 1
+	WRAP   = 2
+)
+
+// Component interaction states.
+const (
+	DOWN     = 2
+	CLICK    = 3
+	OVER     = 4
+	OUT      = 5
+	UP       = 6
+	IS_DOWN  = 7
+	DBLCLICK = 8
+)
+
+// Mouse buttons.
+const (
+	LEFT_BUTTON   = 0
+	MIDDLE_BUTTON = 1
+	RIGHT_BUTTON  = 2
+	ANY_BUTTON    = -1
+)
+
+// CVUI_FILLED fills a shape when used as a thickness.
+const CVUI_FILLED = -1
+
+// Trackbar option flags.
+const (
+	TRACKBAR_HIDE_SEGMENT_LABELS = 1
+	TRACKBAR_HIDE_STEP_SCALE     = 2
+	TRACKBAR_DISCRETE            = 4
+	TRACKBAR_HIDE_MIN_MAX_LABELS = 8
+	TRACKBAR_HIDE_VALUE_LABEL    = 16
+	TRACKBAR_HIDE_LABELS         = 32
+	TRACKBAR_SMOOTH              = 64
+)
+
+func toInt(arg interface{}) int {
+	switch v := arg.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	internal.Fail(ErrBadArgument, "", fmt.Sprintf("expected an int argument, got %T", arg))
+	return 0
+}
+
+func toFloat(arg interface{}) float64 {
+	switch v := arg.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	}
+	internal.Fail(ErrBadArgument, "", fmt.Sprintf("expected a float argument, got %T", arg))
+	return 0
+}
+
+func toColor(arg interface{}) uint32 {
+	switch v := arg.(type) {
+	case uint32:
+		return v
+	case int:
+		return uint32(v)
+	}
+	internal.Fail(ErrBadArgument, "", fmt.Sprintf("expected a color argument, got %T", arg))
+	return 0
+}
+
+// Init initializes the library and starts watching a window. Optional
+// arguments are the delay passed to gocv.WaitKey during Update (-1 to
+// disable the internal WaitKey call) and whether the named window should
+// be created:
+//
+//	Init(windowName)
+//	Init(windowName, delayWaitKey)
+//	Init(windowName, delayWaitKey, createNamedWindow)
+func Init(windowName string, theArgs ...interface{}) {
+	delayWaitKey := -1
+	createNamedWindow := true
+	if len(theArgs) >= 1 {
+		delayWaitKey = toInt(theArgs[0])
+	}
+	if len(theArgs) >= 2 {
+		createNamedWindow = theArgs[1].(bool)
+	}
+	internal.Init(windowName, delayWaitKey, createNamedWindow)
+}
+
+// Watch starts tracking the mouse within an additional window, creating
+// it unless createNamedWindow is given and false.
+func Watch(windowName string, createNamedWindow ...bool) {
+	create := true
+	if len(createNamedWindow) > 0 {
+		create = createNamedWindow[0]
+	}
+	internal.Watch(windowName, create)
+}
+
+// UseContext makes windowName the current context for subsequent
+// component calls, which is required when rendering UI to multiple
+// windows.
+func UseContext(windowName string) {
+	internal.CurrentContext = windowName
+}
+
+// Imshow updates the internal state of windowName and shows frame in it,
+// i.e. it is gocv's IMShow plus Update in a single call.
+func Imshow(windowName string, frame *gocv.Mat) {
+	Update(windowName)
+	window, ok := internal.Windows[windowName]
+	if !ok {
+		internal.Fail(ErrUnknownWindow, "Imshow", "\""+windowName+"\" was not created by Init()/Watch()")
+		return
+	}
+	window.IMShow(*frame)
+}
+
+// LastKeyPressed returns the last key pressed as captured by the
+// internal gocv.WaitKey call, optionally for a particular window: keys
+// are attributed to the window that most recently received mouse
+// events, the closest focus signal OpenCV exposes. Requires a positive
+// delayWaitKey in Init.
+func LastKeyPressed(windowName ...string) int {
+	if len(windowName) > 0 {
+		return internal.GetContext(windowName[0]).LastKeyPressed
+	}
+	return internal.LastKeyPressed
+}
+
+// MousePosition returns the last position of the mouse cursor,
+// optionally within a particular window.
+func MousePosition(windowName ...string) Point {
+	name := ""
+	if len(windowName) > 0 {
+		name = windowName[0]
+	}
+	return internal.GetContext(name).Mouse.Position
+}
+
+// MouseQuery queries the state of a mouse button (or ANY_BUTTON) in the
+// current or given window. Accepted queries are DOWN, UP, CLICK,
+// DBLCLICK and IS_DOWN.
+func MouseQuery(button int, query int, windowName ...string) bool {
+	name := ""
+	if len(windowName) > 0 {
+		name = windowName[0]
+	}
+	mouse := &internal.GetContext(name).Mouse
+
+	mouseButton := mouse.AnyButton
+	if button != ANY_BUTTON {
+		var ok bool
+		mouseButton, ok = mouse.Buttons[button]
+		if !ok {
+			internal.Fail(ErrInvalidButton, "MouseQuery", "use a constant such as gocvui.LEFT_BUTTON")
+			return false
+		}
+	}
+
+	switch query {
+	case DOWN:
+		return mouseButton.JustPressed
+	case UP, CLICK:
+		return mouseButton.JustReleased
+	case DBLCLICK:
+		return mouseButton.DoubleClicked
+	case IS_DOWN:
+		return mouseButton.Pressed
+	}
+	return false
+}
+
+// MouseWheel returns the number of wheel notches scrolled this frame in
+// the current or given window: positive away from the user, negative
+// towards. Scroll areas, lists and zoomable viewers read it once per
+// frame.
+func MouseWheel(windowName ...string) int {
+	name := ""
+	if len(windowName) > 0 {
+		name = windowName[0]
+	}
+	return internal.GetContext(name).Mouse.Wheel
+}
+
+// MouseModifiers returns the modifier keys held during the most recent
+// mouse event of the current or given window, as a combination of the
+// EVENT_FLAG_CTRLKEY, EVENT_FLAG_SHIFTKEY and EVENT_FLAG_ALTKEY flags.
+// Widgets use it for Shift-constrained drags and Ctrl-clicks:
+//
+//	if gocvui.MouseModifiers()&gocvui.EVENT_FLAG_SHIFTKEY != 0 { ... }
+func MouseModifiers(windowName ...string) int {
+	name := ""
+	if len(windowName) > 0 {
+		name = windowName[0]
+	}
+	return internal.GetContext(name).Mouse.Modifiers
+}
+
+// Text renders a piece of text:
+//
+//	Text(frame, x, y, text)
+//	Text(frame, x, y, text, fontScale, color)
+//	Text(text)                                  // inside a begin*/end* block
+//	Text(text, fontScale, color)
+//
+// Deprecated: use TextAt or the begin*/end* flow; this variadic form
+// remains as a compatibility shim.
+func Text(theArgs ...interface{}) {
+	deprecated("Text", "TextAt")
+	fontScale := 0.4
+	hexColor := uint32(0xCECECE)
+
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		x, y := toInt(theArgs[1]), toInt(theArgs[2])
+		text := theArgs[3].(string)
+		if len(theArgs) >= 6 {
+			fontScale = toFloat(theArgs[4])
+			hexColor = toColor(theArgs[5])
+		}
+		internal.Text(internal.ScreenBlock(frame), x, y, text, fontScale, hexColor, false)
+		return
+	}
+
+	block := internal.TopBlock()
+	text := theArgs[0].(string)
+	if len(theArgs) >= 3 {
+		fontScale = toFloat(theArgs[1])
+		hexColor = toColor(theArgs[2])
+	}
+	internal.Text(block, block.Anchor.X, block.Anchor.Y, text, fontScale, hexColor, true)
+}
+
+// Printf renders formatted text:
+//
+//	Printf(frame, x, y, format, args...)
+//	Printf(frame, x, y, fontScale, color, format, args...)
+//	Printf(format, args...)                     // inside a begin*/end* block
+//	Printf(fontScale, color, format, args...)
+func Printf(theArgs ...interface{}) {
+	args := theArgs
+	if frame, ok := args[0].(*gocv.Mat); ok {
+		x, y := toInt(args[1]), toInt(args[2])
+		rest := args[3:]
+		if format, ok := rest[0].(string); ok {
+			Text(frame, x, y, fmt.Sprintf(format, rest[1:]...))
+		} else {
+			fontScale := toFloat(rest[0])
+			hexColor := toColor(rest[1])
+			Text(frame, x, y, fmt.Sprintf(rest[2].(string), rest[3:]...), fontScale, hexColor)
+		}
+		return
+	}
+
+	if format, ok := args[0].(string); ok {
+		Text(fmt.Sprintf(format, args[1:]...))
+	} else {
+		fontScale := toFloat(args[0])
+		hexColor := toColor(args[1])
+		Text(fmt.Sprintf(args[2].(string), args[3:]...), fontScale, hexColor)
+	}
+}
+
+// Button renders a button and returns true every time the user clicks
+// it. The size is computed from the label unless given explicitly:
+//
+//	Button(frame, x, y, label)
+//	Button(frame, x, y, width, height, label)
+//	Button(label)                               // inside a begin*/end* block
+//	Button(width, height, label)
+//
+// Deprecated: use ButtonAt; this variadic form remains as a
+// compatibility shim.
+func Button(theArgs ...interface{}) bool {
+	deprecated("Button", "ButtonAt")
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		x, y := toInt(theArgs[1]), toInt(theArgs[2])
+		if len(theArgs) == 6 {
+			width, height := toInt(theArgs[3]), toInt(theArgs[4])
+			return internal.Button(internal.ScreenBlock(frame), x, y, width, height, theArgs[5].(string), false)
+		}
+		return internal.ButtonAuto(internal.ScreenBlock(frame), x, y, theArgs[3].(string), false)
+	}
+
+	block := internal.TopBlock()
+	if len(theArgs) == 3 {
+		width, height := toInt(theArgs[0]), toInt(theArgs[1])
+		return internal.Button(block, block.Anchor.X, block.Anchor.Y, width, height, theArgs[2].(string), true)
+	}
+	return internal.ButtonAuto(block, block.Anchor.X, block.Anchor.Y, theArgs[0].(string), true)
+}
+
+// Checkbox renders a checkbox bound to state and returns its current
+// value:
+//
+//	Checkbox(frame, x, y, label, &state)
+//	Checkbox(frame, x, y, label, &state, color)
+//	Checkbox(label, &state)                     // inside a begin*/end* block
+//	Checkbox(label, &state, color)
+//
+// Deprecated: use CheckboxAt; this variadic form remains as a
+// compatibility shim.
+func Checkbox(theArgs ...interface{}) bool {
+	deprecated("Checkbox", "CheckboxAt")
+	hexColor := uint32(0xCECECE)
+
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		x, y := toInt(theArgs[1]), toInt(theArgs[2])
+		if len(theArgs) >= 6 {
+			hexColor = toColor(theArgs[5])
+		}
+		return internal.Checkbox(internal.ScreenBlock(frame), x, y, theArgs[3].(string), theArgs[4].(*bool), hexColor, false)
+	}
+
+	block := internal.TopBlock()
+	if len(theArgs) >= 3 {
+		hexColor = toColor(theArgs[2])
+	}
+	return internal.Checkbox(block, block.Anchor.X, block.Anchor.Y, theArgs[0].(string), theArgs[1].(*bool), hexColor, true)
+}
+
+// Counter renders a counter with increment/decrement buttons bound to an
+// *int or *float64 value:
+//
+//	Counter(frame, x, y, &value)
+//	Counter(frame, x, y, &value, step, format)
+//	Counter(&value)                             // inside a begin*/end* block
+//	Counter(&value, step, format)
+//
+// Deprecated: use CounterAt or CounterFloatAt; this variadic form
+// remains as a compatibility shim.
+func Counter(theArgs ...interface{}) {
+	deprecated("Counter", "CounterAt")
+	block := internal.TopBlock
+	x, y := 0, 0
+	updateLayout := true
+	args := theArgs
+
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		block = func() *Block { return internal.ScreenBlock(frame) }
+		x, y = toInt(theArgs[1]), toInt(theArgs[2])
+		updateLayout = false
+		args = theArgs[3:]
+	}
+
+	b := block()
+	if updateLayout {
+		x, y = b.Anchor.X, b.Anchor.Y
+	}
+
+	switch value := args[0].(type) {
+	case *int:
+		step, format := 1, "%d"
+		if len(args) >= 3 {
+			step = toInt(args[1])
+			format = args[2].(string)
+		}
+		internal.Counter(b, x, y, value, step, format, updateLayout)
+	case *float64:
+		step, format := 0.5, "%.2f"
+		if len(args) >= 3 {
+			step = toFloat(args[1])
+			format = args[2].(string)
+		}
+		internal.CounterFloat(b, x, y, value, step, format, updateLayout)
+	default:
+		internal.Fail(ErrBadArgument, "Counter", fmt.Sprintf("expects an *int or *float64 value, got %T", args[0]))
+	}
+}
+
+// Trackbar renders a trackbar bound to a *float64 value and returns true
+// when the value changes:
+//
+//	Trackbar(frame, x, y, width, &value, min, max)
+//	Trackbar(frame, x, y, width, &value, min, max, segments, labelFormat, options, step)
+//	Trackbar(width, &value, min, max)           // inside a begin*/end* block
+//	Trackbar(width, &value, min, max, segments, labelFormat, options, step)
+//
+// Deprecated: use TrackbarAt; this variadic form remains as a
+// compatibility shim.
+func Trackbar(theArgs ...interface{}) bool {
+	deprecated("Trackbar", "TrackbarAt")
+	block := internal.TopBlock
+	x, y := 0, 0
+	updateLayout := true
+	args := theArgs
+
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		block = func() *Block { return internal.ScreenBlock(frame) }
+		x, y = toInt(theArgs[1]), toInt(theArgs[2])
+		updateLayout = false
+		args = theArgs[3:]
+	}
+
+	b := block()
+	if updateLayout {
+		x, y = b.Anchor.X, b.Anchor.Y
+	}
+
+	width := toInt(args[0])
+	value := args[1].(*float64)
+	params := TrackbarParams{
+		Min:         toFloat(args[2]),
+		Max:         toFloat(args[3]),
+		Segments:    1,
+		LabelFormat: "%.1f",
+	}
+	if len(args) >= 5 {
+		params.Segments = toInt(args[4])
+	}
+	if len(args) >= 6 {
+		params.LabelFormat = args[5].(string)
+	}
+	if len(args) >= 7 {
+		params.Options = toInt(args[6])
+	}
+	if len(args) >= 8 {
+		params.Step = toFloat(args[7])
+	}
+	return internal.Trackbar(b, x, y, width, value, params, updateLayout)
+}
+
+// Window renders a window, i.e. a filled rectangle with a title bar:
+//
+//	Window(frame, x, y, width, height, title)
+//	Window(width, height, title)                // inside a begin*/end* block
+//
+// Deprecated: use WindowAt; this variadic form remains as a
+// compatibility shim.
+func Window(theArgs ...interface{}) {
+	deprecated("Window", "WindowAt")
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		x, y := toInt(theArgs[1]), toInt(theArgs[2])
+		width, height := toInt(theArgs[3]), toInt(theArgs[4])
+		internal.Window(internal.ScreenBlock(frame), x, y, width, height, theArgs[5].(string), false)
+		return
+	}
+
+	block := internal.TopBlock()
+	width, height := toInt(theArgs[0]), toInt(theArgs[1])
+	internal.Window(block, block.Anchor.X, block.Anchor.Y, width, height, theArgs[2].(string), true)
+}
+
+// Rectangle renders a rectangle with a border color and an optional
+// filling color (use 0xffffffff for no filling):
+//
+//	Rectangle(frame, x, y, width, height, borderColor)
+//	Rectangle(frame, x, y, width, height, borderColor, fillingColor)
+//	Rectangle(width, height, borderColor)       // inside a begin*/end* block
+//	Rectangle(width, height, borderColor, fillingColor)
+//
+// Deprecated: use RectangleAt; this variadic form remains as a
+// compatibility shim.
+func Rectangle(theArgs ...interface{}) {
+	deprecated("Rectangle", "RectangleAt")
+	fillingColor := uint32(0xffffffff)
+
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		x, y := toInt(theArgs[1]), toInt(theArgs[2])
+		width, height := toInt(theArgs[3]), toInt(theArgs[4])
+		borderColor := toColor(theArgs[5])
+		if len(theArgs) >= 7 {
+			fillingColor = toColor(theArgs[6])
+		}
+		internal.Rect(internal.ScreenBlock(frame), x, y, width, height, borderColor, fillingColor, false)
+		return
+	}
+
+	block := internal.TopBlock()
+	width, height := toInt(theArgs[0]), toInt(theArgs[1])
+	borderColor := toColor(theArgs[2])
+	if len(theArgs) >= 4 {
+		fillingColor = toColor(theArgs[3])
+	}
+	internal.Rect(block, block.Anchor.X, block.Anchor.Y, width, height, borderColor, fillingColor, true)
+}
+
+// Sparkline renders a slice of float64 values as a compact line chart:
+//
+//	Sparkline(frame, values, x, y, width, height)
+//	Sparkline(frame, values, x, y, width, height, color)
+//	Sparkline(values, width, height)            // inside a begin*/end* block
+//	Sparkline(values, width, height, color)
+//
+// Deprecated: use SparklineAt; this variadic form remains as a
+// compatibility shim.
+func Sparkline(theArgs ...interface{}) {
+	deprecated("Sparkline", "SparklineAt")
+	hexColor := uint32(0x00FF00)
+
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		values := theArgs[1].([]float64)
+		x, y := toInt(theArgs[2]), toInt(theArgs[3])
+		width, height := toInt(theArgs[4]), toInt(theArgs[5])
+		if len(theArgs) >= 7 {
+			hexColor = toColor(theArgs[6])
+		}
+		internal.Sparkline(internal.ScreenBlock(frame), values, x, y, width, height, hexColor, false)
+		return
+	}
+
+	block := internal.TopBlock()
+	values := theArgs[0].([]float64)
+	width, height := toInt(theArgs[1]), toInt(theArgs[2])
+	if len(theArgs) >= 4 {
+		hexColor = toColor(theArgs[3])
+	}
+	internal.Sparkline(block, values, block.Anchor.X, block.Anchor.Y, width, height, hexColor, true)
+}
+
+// Image renders a gocv.Mat:
+//
+//	Image(frame, x, y, image)
+//	Image(image)                                // inside a begin*/end* block
+//
+// Deprecated: use ImageAt; this variadic form remains as a
+// compatibility shim.
+func Image(theArgs ...interface{}) {
+	deprecated("Image", "ImageAt")
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		x, y := toInt(theArgs[1]), toInt(theArgs[2])
+		internal.Image(internal.ScreenBlock(frame), x, y, theArgs[3].(gocv.Mat), false)
+		return
+	}
+
+	block := internal.TopBlock()
+	internal.Image(block, block.Anchor.X, block.Anchor.Y, theArgs[0].(gocv.Mat), true)
+}
+
+// IArea checks the mouse interaction state of a rectangular area,
+// returning OUT, OVER, DOWN or CLICK.
+func IArea(x, y, width, height int) int {
+	return internal.IArea(x, y, width, height)
+}
+
+// BeginRow starts a row block, where components are laid out
+// horizontally:
+//
+//	BeginRow(frame, x, y)
+//	BeginRow(frame, x, y, width, height, padding)
+//	BeginRow()                                  // nested inside another block
+//	BeginRow(width, height, padding)
+func BeginRow(theArgs ...interface{}) {
+	beginBlock(ROW, theArgs)
+}
+
+// EndRow ends a row block.
+func EndRow() {
+	internal.EndBlock()
+}
+
+// BeginColumn starts a column block, where components are laid out
+// vertically. See BeginRow for the accepted arguments.
+func BeginColumn(theArgs ...interface{}) {
+	beginBlock(COLUMN, theArgs)
+}
+
+// EndColumn ends a column block.
+func EndColumn() {
+	internal.EndBlock()
+}
+
+// BeginWrap starts a flow block: components are laid out horizontally
+// like a row, but wrap onto a new line once a component crosses the
+// block width. The consumed height flows back into the parent block,
+// so wrap blocks compose with rows and columns. See BeginRow for the
+// accepted arguments; pass an explicit width for wrapping to have any
+// effect.
+func BeginWrap(theArgs ...interface{}) {
+	beginBlock(WRAP, theArgs)
+}
+
+// EndWrap ends a wrap block.
+func EndWrap() {
+	internal.EndBlock()
+}
+
+func beginBlock(blockType int, theArgs []interface{}) {
+	width, height, padding := -1, -1, 0
+
+	if len(theArgs) > 0 {
+		if frame, ok := theArgs[0].(*gocv.Mat); ok {
+			x, y := toInt(theArgs[1]), toInt(theArgs[2])
+			if len(theArgs) >= 5 {
+				width, height = toInt(theArgs[3]), toInt(theArgs[4])
+			}
+			if len(theArgs) >= 6 {
+				padding = toInt(theArgs[5])
+			}
+			internal.BeginBlock(frame, x, y, width, height, padding, blockType)
+			return
+		}
+		if len(theArgs) >= 2 {
+			width, height = toInt(theArgs[0]), toInt(theArgs[1])
+		}
+		if len(theArgs) >= 3 {
+			padding = toInt(theArgs[2])
+		}
+	}
+
+	parent := internal.TopBlock()
+	internal.BeginBlock(parent.Where, parent.Anchor.X, parent.Anchor.Y, width, height, padding, blockType)
+}
+
+// Space adds a space of the given size (default 5px) between components
+// inside a begin*/end* block.
+func Space(value ...int) {
+	size := 5
+	if len(value) > 0 {
+		size = value[0]
+	}
+	internal.UpdateLayoutFlow(internal.TopBlock(), Size{size, size})
+}
+
+// Update resets the per-frame library state, e.g. mouse click events.
+// It must be called after all components have been rendered, once per
+// frame and per window. When DelayWaitKey is positive, Update also
+// pumps the OpenCV event loop via gocv.WaitKey.
+func Update(windowName ...string) {
+	name := ""
+	if len(windowName) > 0 {
+		name = windowName[0]
+	}
+	internal.Update(name)
+	pumpWaitKey()
+}
@@ -0,0 +1,36 @@
+package gocvui
+
+import (
+	"image"
+
+	"gocv.io/x/gocv"
+)
+
+// RenderBackend is the primitive set the rendering layer delegates to
+// when an alternative backend is registered. An implementation backed
+// by gocv.UMat or a CUDA GpuMat would keep a GPU mirror of each frame
+// Mat it is handed — the Mat pointer identifies the frame — and run
+// the draw calls there, downloading only at Imshow time.
+//
+// This is experimental: gocv currently exposes no UMat or GpuMat
+// drawing primitives, so no backend ships with the library, but the
+// hook lets one slot in without API changes once it does (or through
+// custom cgo bindings). All geometry arrives pre-scaled in pixel
+// coordinates.
+type RenderBackend interface {
+	Rectangle(where *gocv.Mat, area image.Rectangle, hexColor uint32, thickness int)
+	Line(where *gocv.Mat, from, to image.Point, hexColor uint32, thickness int)
+	Circle(where *gocv.Mat, center image.Point, radius int, hexColor uint32, thickness int)
+	PutText(where *gocv.Mat, text string, position image.Point, fontScale float64, hexColor uint32)
+}
+
+// renderBackend is the active backend; nil means the stock CPU path.
+var renderBackend RenderBackend
+
+// SetRenderBackend installs an experimental rendering backend for the
+// core primitives — rectangles, lines, circles and text — leaving
+// composite rendering (images, sparklines) on the CPU path. Pass nil
+// to restore the stock renderer.
+func SetRenderBackend(backend RenderBackend) {
+	renderBackend = backend
+}
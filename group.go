@@ -0,0 +1,47 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	groupPadding      = 8
+	groupCaptionInset = 10
+)
+
+// BeginGroup starts a group box: a bordered area with a caption that
+// straddles its top edge, used to visually cluster related controls.
+// Children flow top to bottom inside the border with an automatic
+// inset; close the group with EndGroup:
+//
+//	gocvui.BeginGroup(&frame, 10, 10, 180, 90, "Detection")
+//	gocvui.Checkbox("Enabled", &enabled)
+//	gocvui.Trackbar(150, &threshold, params)
+//	gocvui.EndGroup()
+func BeginGroup(frame *gocv.Mat, x, y, width, height int, title string) {
+	block := internal.ScreenBlock(frame)
+	size := render.GetTextSize(title, 0.4)
+	captionHeight := size.Height + 6
+
+	border := Rect{x, y + captionHeight/2, width, height - captionHeight/2}
+	render.rectangle(block.Where, border, ColorBorder, 1)
+
+	caption := Rect{x + groupCaptionInset, y, size.Width + 10, captionHeight}
+	render.rectangle(block.Where, caption, ColorPanel, CVUI_FILLED)
+	render.putText(block.Where, title, Point{caption.X + 5, y + size.Height + 2}, 0.4, ColorText)
+
+	internal.Describe("group", title, "", OUT, Rect{x, y, width, height})
+
+	inner := Rect{
+		x + groupPadding,
+		y + captionHeight + groupPadding/2,
+		width - 2*groupPadding,
+		height - captionHeight - groupPadding/2 - groupPadding,
+	}
+	internal.BeginBlock(frame, inner.X, inner.Y, inner.Width, inner.Height, 4, COLUMN)
+}
+
+// EndGroup closes the block opened by BeginGroup.
+func EndGroup() {
+	internal.EndBlock()
+}
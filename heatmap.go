@@ -0,0 +1,127 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// Colormap selects the palette used by Heatmap.
+type Colormap int
+
+const (
+	ColormapJet Colormap = iota
+	ColormapViridis
+	ColormapGray
+)
+
+const (
+	heatmapLegendWidth = 10
+	heatmapLegendGap   = 4
+	heatmapSampleStep  = 2
+)
+
+// colormapStops are the gradient control points of each palette.
+var colormapStops = map[Colormap][]uint32{
+	ColormapJet:     {0x00007F, 0x0000FF, 0x00FFFF, 0xFFFF00, 0xFF0000, 0x7F0000},
+	ColormapViridis: {0x440154, 0x3B528B, 0x21908C, 0x5DC863, 0xFDE725},
+	ColormapGray:    {0x000000, 0xFFFFFF},
+}
+
+// Heatmap renders a 2D float grid as a colormapped image scaled into
+// the width x height area at (x, y), with a legend bar showing the
+// min/max range on the right. Rows of the grid run top to bottom.
+func Heatmap(frame *gocv.Mat, x, y, width, height int, grid [][]float64, colormap Colormap) {
+	block := internal.ScreenBlock(frame)
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		internal.Text(block, x, y, "No data.", 0.4, ColorText, false)
+		return
+	}
+
+	min, max := grid[0][0], grid[0][0]
+	for _, row := range grid {
+		for _, v := range row {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+
+	cells := Rect{x, y, width - heatmapLegendWidth - heatmapLegendGap, height}
+	cellWidth := float64(cells.Width) / float64(len(grid[0]))
+	cellHeight := float64(cells.Height) / float64(len(grid))
+
+	for row := range grid {
+		for col, v := range grid[row] {
+			cell := Rect{
+				X:      cells.X + int(float64(col)*cellWidth),
+				Y:      cells.Y + int(float64(row)*cellHeight),
+				Width:  int(cellWidth) + 1,
+				Height: int(cellHeight) + 1,
+			}
+			render.rectangle(block.Where, cell, colormapColor(normalized(v, min, max), colormap), CVUI_FILLED)
+		}
+	}
+
+	renderHeatmapLegend(block, Rect{x + width - heatmapLegendWidth, y, heatmapLegendWidth, height}, min, max, colormap)
+}
+
+// HeatmapMat renders a single-channel float Mat as a heatmap by
+// sampling it down to the resolution of the target area.
+func HeatmapMat(frame *gocv.Mat, x, y, width, height int, mat gocv.Mat, colormap Colormap) {
+	rows, cols := mat.Rows(), mat.Cols()
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	gridRows := height / heatmapSampleStep
+	gridCols := (width - heatmapLegendWidth - heatmapLegendGap) / heatmapSampleStep
+	if gridRows < 1 || gridCols < 1 {
+		return
+	}
+
+	grid := make([][]float64, gridRows)
+	for row := range grid {
+		grid[row] = make([]float64, gridCols)
+		for col := range grid[row] {
+			grid[row][col] = float64(mat.GetFloatAt(row*rows/gridRows, col*cols/gridCols))
+		}
+	}
+	Heatmap(frame, x, y, width, height, grid, colormap)
+}
+
+// renderHeatmapLegend draws the vertical gradient bar with the min and
+// max labels.
+func renderHeatmapLegend(block *Block, bar Rect, min, max float64, colormap Colormap) {
+	for i := 0; i < bar.Height; i++ {
+		// A one-pixel bar would divide 0 by 0; show the top stop.
+		t := 1.0
+		if bar.Height > 1 {
+			t = 1 - float64(i)/float64(bar.Height-1)
+		}
+		render.line(block.Where, Point{bar.X, bar.Y + i}, Point{bar.X + bar.Width, bar.Y + i}, colormapColor(t, colormap))
+	}
+	render.rectangle(block.Where, bar, ColorBorder, 1)
+
+	maxText := formatFloat(max)
+	render.putText(block.Where, maxText, Point{bar.X - render.GetTextSize(maxText, 0.3).Width - 2, bar.Y + 8}, 0.3, theme.TextColor)
+	minText := formatFloat(min)
+	render.putText(block.Where, minText, Point{bar.X - render.GetTextSize(minText, 0.3).Width - 2, bar.Y + bar.Height - 2}, 0.3, theme.TextColor)
+}
+
+// colormapColor maps a normalized value to its palette color.
+func colormapColor(t float64, colormap Colormap) uint32 {
+	stops, ok := colormapStops[colormap]
+	if !ok {
+		stops = colormapStops[ColormapJet]
+	}
+	t = clampFloat(t, 0, 1)
+
+	segment := t * float64(len(stops)-1)
+	index := int(segment)
+	if index >= len(stops)-1 {
+		return stops[len(stops)-1]
+	}
+	return lerpColor(stops[index], stops[index+1], segment-float64(index))
+}
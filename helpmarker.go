@@ -0,0 +1,83 @@
+package gocvui
+
+import (
+	"strings"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	helpTooltipWidth   = 200
+	helpTooltipPadding = 6
+	helpLineHeight     = 14
+)
+
+// HelpMarker renders a small "(?)" glyph at the current anchor of the
+// enclosing row or column block, next to the widget laid out before
+// it. Hovering the glyph shows text as a wrapped tooltip, documenting
+// parameters directly in the tuning UI. It can only be used between
+// begin*()/end*() calls.
+func HelpMarker(text string) {
+	block := internal.TopBlock()
+	size := render.GetTextSize("(?)", 0.4)
+	position := Point{block.Anchor.X, block.Anchor.Y}
+
+	status := internal.IArea(position.X, position.Y, size.Width, size.Height+4)
+	hexColor := ColorDimText
+	if status != OUT {
+		hexColor = theme.TextColor
+	}
+	render.putText(block.Where, "(?)", Point{position.X, position.Y + size.Height + 2}, 0.4, hexColor)
+
+	if status != OUT {
+		where := block.Where
+		mouse := internal.GetContext("").Mouse.Position
+		internal.QueueOverlay(func() {
+			renderHelpTooltip(where, mouse, text)
+		})
+	}
+
+	internal.UpdateLayoutFlow(block, Size{size.Width + 4, size.Height + 4})
+}
+
+// renderHelpTooltip draws the wrapped tooltip near the cursor.
+func renderHelpTooltip(where *gocv.Mat, at Point, text string) {
+	lines := wrapText(text, helpTooltipWidth-2*helpTooltipPadding, 0.4)
+
+	area := Rect{at.X + 12, at.Y + 12, helpTooltipWidth, len(lines)*helpLineHeight + 2*helpTooltipPadding}
+	if area.X+area.Width > where.Cols() {
+		area.X = where.Cols() - area.Width
+	}
+	if area.Y+area.Height > where.Rows() {
+		area.Y = at.Y - area.Height - 4
+	}
+
+	render.rectangle(where, area, ColorInset, CVUI_FILLED)
+	render.rectangle(where, area, ColorBorder, 1)
+	for i, line := range lines {
+		render.putText(where, line, Point{area.X + helpTooltipPadding, area.Y + helpTooltipPadding + i*helpLineHeight + 9}, 0.4, theme.TextColor)
+	}
+}
+
+// wrapText splits text into lines no wider than maxWidth at the given
+// font scale, breaking on spaces.
+func wrapText(text string, maxWidth int, fontScale float64) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if render.GetTextSize(candidate, fontScale).Width > maxWidth && line != "" {
+			lines = append(lines, line)
+			line = word
+			continue
+		}
+		line = candidate
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,68 @@
+package gocvui
+
+import (
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	histBins        = 32
+	histScoreHeight = 16
+)
+
+// histChannelColors are the line colors of the B, G and R channel
+// histograms; the second image renders in dimmed versions of them.
+var histChannelColors = [3]uint32{0x4040FF, 0x40FF40, 0xFF4040}
+
+// HistCompare computes per-channel color histograms of imgA and imgB,
+// draws them overlaid at (x, y) — imgA in full color, imgB dimmed —
+// and displays the gocv.CompareHist score averaged over the channels.
+// Handy for spotting exposure or white-balance drift between cameras.
+func HistCompare(frame *gocv.Mat, x, y, width, height int, imgA, imgB gocv.Mat, method gocv.HistCompMethod) {
+	block := internal.ScreenBlock(frame)
+	area := Rect{x, y, width, height}
+
+	render.rectangle(block.Where, area, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorBorder, 1)
+
+	chart := Rect{x + 4, y + 4, width - 8, height - histScoreHeight - 8}
+	score := 0.0
+
+	for channel := 0; channel < 3; channel++ {
+		histA := channelHistogram(imgA, channel)
+		histB := channelHistogram(imgB, channel)
+
+		renderHistogram(block, chart, histA, histChannelColors[channel])
+		renderHistogram(block, chart, histB, lerpColor(histChannelColors[channel], ColorInset, 0.5))
+
+		score += float64(gocv.CompareHist(histA, histB, method))
+		histA.Close()
+		histB.Close()
+	}
+
+	text := fmt.Sprintf("score: %.3f", score/3)
+	size := render.GetTextSize(text, 0.4)
+	render.putText(block.Where, text, Point{x + 4, y + height - histScoreHeight + size.Height}, 0.4, theme.TextColor)
+}
+
+// channelHistogram computes the normalized histogram of one channel of
+// img. The caller owns the returned Mat.
+func channelHistogram(img gocv.Mat, channel int) gocv.Mat {
+	hist := gocv.NewMat()
+	mask := gocv.NewMat()
+	gocv.CalcHist([]gocv.Mat{img}, []int{channel}, mask, &hist, []int{histBins}, []float64{0, 256}, false)
+	mask.Close()
+	gocv.Normalize(hist, &hist, 0, 1, gocv.NormMinMax)
+	return hist
+}
+
+// renderHistogram draws one histogram as a polyline filling chart.
+func renderHistogram(block *Block, chart Rect, hist gocv.Mat, hexColor uint32) {
+	gap := float64(chart.Width) / float64(histBins-1)
+	for i := 0; i < histBins-1; i++ {
+		y1 := float64(chart.Y+chart.Height) - float64(hist.GetFloatAt(i, 0))*float64(chart.Height)
+		y2 := float64(chart.Y+chart.Height) - float64(hist.GetFloatAt(i+1, 0))*float64(chart.Height)
+		render.line(block.Where, Point{chart.X + int(float64(i)*gap), int(y1)}, Point{chart.X + int(float64(i+1)*gap), int(y2)}, hexColor)
+	}
+}
@@ -0,0 +1,36 @@
+package gocvui
+
+// hitRegion is the footprint one widget left during the last frame,
+// recorded by Describe for hit-testing.
+type hitRegion struct {
+	kind string
+	rect Rect
+}
+
+// WidgetAt returns the kind of the widget under (x, y) — "button",
+// "trackbar", "window" and so on — or the empty string when the point
+// touches no widget. The topmost widget wins, i.e. the one rendered
+// last. Positions are checked against the widgets of the last completed
+// frame, the only full picture an immediate-mode pass has.
+func WidgetAt(x, y int) string {
+	p := Point{x, y}
+	for i := len(internal.lastHitRegions) - 1; i >= 0; i-- {
+		if internal.lastHitRegions[i].rect.Contains(p) {
+			return internal.lastHitRegions[i].kind
+		}
+	}
+	return ""
+}
+
+// IsAnyWidgetHovered reports whether the mouse cursor of the current
+// window is over any widget, so application-level mouse handling —
+// panning the underlying image, say — can step aside while the user
+// works the UI:
+//
+//	if !gocvui.IsAnyWidgetHovered() {
+//		pan(gocvui.MousePosition())
+//	}
+func IsAnyWidgetHovered() bool {
+	position := internal.GetContext("").Mouse.Position
+	return WidgetAt(position.X, position.Y) != ""
+}
@@ -0,0 +1,99 @@
+package gocvui
+
+import (
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const hoverPreviewDelay = 500 * time.Millisecond
+
+// hoverPreviewState tracks which item is being hovered and since when.
+type hoverPreviewState struct {
+	Index int
+	Since time.Duration
+}
+
+// HoverPreview shows a popup after the cursor rests on one of the item
+// rects for a delay: preview is called with the hovered index and an
+// anchor point next to the cursor and draws whatever it likes — an
+// image thumbnail, detail text — on the overlay layer, so it is
+// composited above the widgets rendered later in the frame. Pass the
+// rects of ListBox rows, Tree nodes or Gallery thumbnails as items;
+// a zero delay means 500ms.
+func HoverPreview(frame *gocv.Mat, items []Rect, delay time.Duration, preview func(where *gocv.Mat, index int, at Point)) {
+	if len(items) == 0 || preview == nil {
+		return
+	}
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+	if delay <= 0 {
+		delay = hoverPreviewDelay
+	}
+
+	state := internal.State(internal.StateID("hoverpreview", items[0].X, items[0].Y), func() interface{} {
+		return &hoverPreviewState{Index: -1}
+	}).(*hoverPreviewState)
+
+	hovered := -1
+	for i, item := range items {
+		if item.Contains(mouse.Position) {
+			hovered = i
+			break
+		}
+	}
+
+	if hovered != state.Index {
+		state.Index = hovered
+		state.Since = internal.Clock.Now()
+	}
+	if hovered < 0 || internal.Clock.Now()-state.Since < delay {
+		return
+	}
+
+	at := Point{mouse.Position.X + 12, mouse.Position.Y + 12}
+	internal.QueueOverlay(func() {
+		preview(block.Where, hovered, at)
+	})
+}
+
+// PreviewText is a ready-made HoverPreview callback body: it draws
+// lines of detail text in a panel at the anchor point.
+func PreviewText(where *gocv.Mat, at Point, lines []string) {
+	width := 0
+	for _, line := range lines {
+		if size := render.GetTextSize(line, 0.3); size.Width > width {
+			width = size.Width
+		}
+	}
+
+	box := Rect{at.X, at.Y, width + 10, len(lines)*pixelInspectorRowHeight + 8}
+	if box.X+box.Width > where.Cols() {
+		box.X = maxInt(where.Cols()-box.Width, 0)
+	}
+	if box.Y+box.Height > where.Rows() {
+		box.Y = maxInt(where.Rows()-box.Height, 0)
+	}
+
+	render.rectangle(where, box, ColorPanel, CVUI_FILLED)
+	render.rectangle(where, box, ColorBorder, 1)
+	for i, line := range lines {
+		render.putText(where, line, Point{box.X + 5, box.Y + (i+1)*pixelInspectorRowHeight}, 0.3, theme.TextColor)
+	}
+}
+
+// PreviewImage is a ready-made HoverPreview callback body: it draws a
+// framed thumbnail at the anchor point.
+func PreviewImage(where *gocv.Mat, at Point, thumb gocv.Mat) {
+	box := Rect{at.X, at.Y, thumb.Cols() + 8, thumb.Rows() + 8}
+	if box.X+box.Width > where.Cols() {
+		box.X = maxInt(where.Cols()-box.Width, 0)
+	}
+	if box.Y+box.Height > where.Rows() {
+		box.Y = maxInt(where.Rows()-box.Height, 0)
+	}
+
+	render.rectangle(where, box, ColorPanel, CVUI_FILLED)
+	render.rectangle(where, box, ColorBorder, 1)
+	render.Image(internal.ScreenBlock(where), Rect{box.X + 4, box.Y + 4, thumb.Cols(), thumb.Rows()}, thumb)
+}
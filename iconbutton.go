@@ -0,0 +1,55 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const iconButtonPadding = 6
+
+// IconButton renders a button with a small icon Mat drawn left of the
+// label — the image-button and text-button behaviors in a single call.
+// The icon is drawn at its own size, vertically centered; pass an
+// empty label for an icon-only button. Returns true on click, like
+// Button.
+func IconButton(frame *gocv.Mat, x, y, width, height int, icon gocv.Mat, label string) bool {
+	block := internal.ScreenBlock(frame)
+	return internal.IconButton(block, x, y, width, height, icon, label, false)
+}
+
+// IconButton is the internal renderer behind the public IconButton.
+func (in *Internal) IconButton(block *Block, x, y, width, height int, icon gocv.Mat, label string, updateLayout bool) bool {
+	shape := Rect{x, y, width, height}
+	status := in.IArea(x, y, width, height)
+
+	state := OUT
+	if status == OVER {
+		state = OVER
+	} else if status == DOWN {
+		state = DOWN
+	}
+
+	render.Button(block, state, shape)
+
+	contentX := x + iconButtonPadding
+	if !icon.Empty() {
+		iconRect := Rect{contentX, y + (height-icon.Rows())/2, icon.Cols(), icon.Rows()}
+		render.Image(block, iconRect, icon)
+		contentX += icon.Cols() + iconButtonPadding
+	}
+	if label != "" {
+		size := render.GetTextSize(in.CreateLabel(label).Plain(), 0.4)
+		labelArea := Rect{contentX, y, x + width - contentX, height}
+		render.ButtonLabel(block, state, labelArea, label, size)
+	}
+	in.Describe("iconbutton", in.CreateLabel(label).Plain(), "", status, shape)
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{width, height})
+	}
+
+	parsed := in.CreateLabel(label)
+	if parsed.HasShortcut && (in.LastKeyPressed == int(parsed.Shortcut) || in.LastKeyPressed == int(parsed.Shortcut)+32) {
+		return true
+	}
+	return status == CLICK
+}
@@ -0,0 +1,506 @@
+package gocvui
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// OpenCV mouse event constants, mirrored here because gocv does not
+// export all of them.
+const (
+	EVENT_MOUSEMOVE     = 0
+	EVENT_LBUTTONDOWN   = 1
+	EVENT_RBUTTONDOWN   = 2
+	EVENT_MBUTTONDOWN   = 3
+	EVENT_LBUTTONUP     = 4
+	EVENT_RBUTTONUP     = 5
+	EVENT_MBUTTONUP     = 6
+	EVENT_LBUTTONDBLCLK = 7
+	EVENT_RBUTTONDBLCLK = 8
+	EVENT_MBUTTONDBLCLK = 9
+	EVENT_MOUSEWHEEL    = 10
+	EVENT_MOUSEHWHEEL   = 11
+)
+
+// OpenCV mouse event flags, mirrored for the same reason.
+const (
+	EVENT_FLAG_LBUTTON  = 1
+	EVENT_FLAG_RBUTTON  = 2
+	EVENT_FLAG_MBUTTON  = 4
+	EVENT_FLAG_CTRLKEY  = 8
+	EVENT_FLAG_SHIFTKEY = 16
+	EVENT_FLAG_ALTKEY   = 32
+)
+
+// Internal holds the global state of the library.
+type Internal struct {
+	DefaultContext     string
+	CurrentContext     string
+	Contexts           map[string]*Context
+	Windows            map[string]*gocv.Window
+	LastKeyPressed     int // last key seen by any window; see Context.LastKeyPressed
+	DelayWaitKey       int
+	Screen             *Block
+	Stack              []*Block
+	StackCount         int
+	TrackbarMarginX    int
+	Clock              Clock
+	States             map[string]interface{}
+	Persistent         map[string]interface{}
+	persistentRaw      map[string]json.RawMessage
+	overlays           []func()
+	errorHandler       func(error)
+	orphanContext      *Context
+	logger             Logger
+	warned             map[string]bool
+	watchdogBudget     time.Duration
+	frameUIStart       time.Time
+	describing         bool
+	descriptions       []WidgetDescription
+	lastDescriptions   []WidgetDescription
+	modalRect          Rect
+	modalActive        bool
+	modalSeen          bool
+	inModal            bool
+	disabled           bool
+	stepMode           bool
+	lastInteraction    string
+	stretchScopes      []stretchScope
+	lastItemRect       Rect
+	nextMinSize        Size
+	nextMaxSize        Size
+	layoutDebug        bool
+	overlayRects       []Rect
+	activeOverlayRects []Rect
+	inOverlay          bool
+	keys               map[int]*keyState
+	textInput          string
+	shortcuts          map[int]func()
+	focusedWindow      string
+	externalKeys       bool
+	hitRegions         []hitRegion
+	lastHitRegions     []hitRegion
+}
+
+var internal = NewInternal()
+
+// NewInternal returns the state of a freshly initialized library.
+func NewInternal() *Internal {
+	in := &Internal{
+		Contexts:        map[string]*Context{},
+		Windows:         map[string]*gocv.Window{},
+		LastKeyPressed:  -1,
+		DelayWaitKey:    -1,
+		Screen:          &Block{},
+		StackCount:      -1,
+		TrackbarMarginX: 14,
+		States:          map[string]interface{}{},
+		Persistent:      map[string]interface{}{},
+		persistentRaw:   map[string]json.RawMessage{},
+		warned:          map[string]bool{},
+	}
+	return in
+}
+
+// Init initializes the library state and starts watching windowName.
+func (in *Internal) Init(windowName string, delayWaitKey int, createNamedWindow bool) {
+	in.DefaultContext = windowName
+	in.CurrentContext = windowName
+	in.DelayWaitKey = delayWaitKey
+	in.LastKeyPressed = -1
+	in.Watch(windowName, createNamedWindow)
+}
+
+// Watch starts tracking the mouse within the window windowName. The
+// window is created with default options unless createNamedWindow is
+// false, in which case the caller is responsible for having created it
+// already.
+func (in *Internal) Watch(windowName string, createNamedWindow bool) {
+	in.WatchWithOptions(windowName, createNamedWindow, nil)
+}
+
+// WatchWithOptions is Watch with explicit window creation options.
+func (in *Internal) WatchWithOptions(windowName string, createNamedWindow bool, options *WindowOptions) {
+	if _, ok := in.Contexts[windowName]; ok {
+		in.Warn("window is already being watched", "window", windowName)
+	}
+	context := &Context{
+		WindowName:     windowName,
+		Mouse:          newMouse(),
+		LastKeyPressed: -1,
+	}
+	in.Contexts[windowName] = context
+
+	if createNamedWindow {
+		window := gocv.NewWindow(windowName)
+		if options != nil {
+			options.apply(window)
+		}
+		window.SetMouseHandler(func(event int, x int, y int, flags int, userdata interface{}) {
+			handleMouse(event, x, y, flags, context)
+		}, nil)
+		in.Windows[windowName] = window
+	}
+}
+
+// GetContext returns the context of windowName, or the current/default
+// one when windowName is empty.
+func (in *Internal) GetContext(windowName string) *Context {
+	name := windowName
+	if name == "" {
+		name = in.CurrentContext
+	}
+	if name == "" {
+		name = in.DefaultContext
+	}
+	if name == "" {
+		in.Fail(ErrNoContext, "", "did you forget to call gocvui.Init()?")
+		return in.orphan()
+	}
+	context, ok := in.Contexts[name]
+	if !ok {
+		in.Fail(ErrUnknownWindow, "", "no context for \""+name+"\"; did you forget to call gocvui.Watch()?")
+		return in.orphan()
+	}
+	return context
+}
+
+// orphan returns a throwaway context so rendering can carry on after a
+// context error was handed to an application error handler.
+func (in *Internal) orphan() *Context {
+	if in.orphanContext == nil {
+		in.orphanContext = &Context{Mouse: newMouse()}
+	}
+	return in.orphanContext
+}
+
+// handleMouse updates the mouse state of context from an OpenCV mouse
+// callback event.
+func handleMouse(event int, x int, y int, flags int, context *Context) {
+	buttons := map[int]int{
+		EVENT_LBUTTONDOWN: LEFT_BUTTON,
+		EVENT_LBUTTONUP:   LEFT_BUTTON,
+		EVENT_RBUTTONDOWN: RIGHT_BUTTON,
+		EVENT_RBUTTONUP:   RIGHT_BUTTON,
+		EVENT_MBUTTONDOWN: MIDDLE_BUTTON,
+		EVENT_MBUTTONUP:   MIDDLE_BUTTON,
+	}
+	eventsDown := map[int]bool{
+		EVENT_LBUTTONDOWN: true,
+		EVENT_RBUTTONDOWN: true,
+		EVENT_MBUTTONDOWN: true,
+	}
+
+	doubleClicks := map[int]int{
+		EVENT_LBUTTONDBLCLK: LEFT_BUTTON,
+		EVENT_RBUTTONDBLCLK: RIGHT_BUTTON,
+		EVENT_MBUTTONDBLCLK: MIDDLE_BUTTON,
+	}
+
+	// Mouse events are the closest focus signal OpenCV exposes; the
+	// window receiving them is where key presses are routed.
+	internal.focusedWindow = context.WindowName
+
+	context.Mouse.Modifiers = flags & (EVENT_FLAG_CTRLKEY | EVENT_FLAG_SHIFTKEY | EVENT_FLAG_ALTKEY)
+
+	if event == EVENT_MOUSEWHEEL {
+		context.Mouse.Wheel += wheelNotches(flags)
+	}
+
+	if button, ok := doubleClicks[event]; ok {
+		context.Mouse.Buttons[button].DoubleClicked = true
+		context.Mouse.AnyButton.DoubleClicked = true
+	}
+
+	if button, ok := buttons[event]; ok {
+		mouseButton := context.Mouse.Buttons[button]
+		if eventsDown[event] {
+			mouseButton.JustPressed = true
+			mouseButton.Pressed = true
+			context.Mouse.AnyButton.JustPressed = true
+			context.Mouse.AnyButton.Pressed = true
+		} else {
+			mouseButton.JustReleased = true
+			mouseButton.Pressed = false
+			context.Mouse.AnyButton.JustReleased = true
+			context.Mouse.AnyButton.Pressed = false
+		}
+	}
+
+	// Map window coordinates back to the logical layout of a scaled
+	// context (see SetScaleForWindow).
+	if context.Scale > 0 && context.Scale != 1 {
+		x = int(float64(x) / context.Scale)
+		y = int(float64(y) / context.Scale)
+	}
+
+	context.Mouse.Position.X = x
+	context.Mouse.Position.Y = y
+}
+
+// routeKey records a key reported by the waitKey pump on the context
+// of the focused window, falling back to the default one while no
+// window has received mouse events yet.
+func (in *Internal) routeKey(code int) {
+	if code < 0 {
+		return
+	}
+	name := in.focusedWindow
+	if name == "" {
+		name = in.DefaultContext
+	}
+	if context, ok := in.Contexts[name]; ok {
+		context.LastKeyPressed = code
+	}
+}
+
+// wheelNotches decodes the wheel delta OpenCV packs into the high bits
+// of the mouse callback flags, normalized to whole notches of the
+// usual 120-unit step; finer-grained deltas still report their sign.
+func wheelNotches(flags int) int {
+	delta := int(int16(flags >> 16))
+	if notches := delta / 120; notches != 0 {
+		return notches
+	}
+	if delta > 0 {
+		return 1
+	}
+	if delta < 0 {
+		return -1
+	}
+	return 0
+}
+
+// IArea checks the mouse interaction state of a rectangular area,
+// returning OUT, OVER, DOWN or CLICK.
+func (in *Internal) IArea(x, y, width, height int) int {
+	// While a modal is open, only widgets rendered inside it react.
+	if in.modalActive && !in.inModal {
+		return OUT
+	}
+	// Widgets inside an EnableIf(false) scope never react.
+	if in.disabled {
+		return OUT
+	}
+
+	mouse := &in.GetContext("").Mouse
+	// Overlay widgets win the mouse over anything underneath them.
+	if !in.inOverlay && in.overlayAt(mouse.Position) {
+		return OUT
+	}
+	area := Rect{x, y, width, height}
+
+	status := OUT
+	if area.Contains(mouse.Position) {
+		status = OVER
+		if mouse.AnyButton.Pressed {
+			status = DOWN
+		}
+		if mouse.AnyButton.JustReleased {
+			status = CLICK
+		}
+		if mouse.AnyButton.DoubleClicked {
+			status = DBLCLICK
+		}
+	}
+	if in.stepMode && status != OUT {
+		in.lastInteraction = fmt.Sprintf("%s @ %d,%d %dx%d", stateName(status), x, y, width, height)
+	}
+	return status
+}
+
+// State returns the persistent cross-frame state stored under id,
+// initializing it with init on first use. Stateful components use it to
+// remember things like scroll offsets between frames.
+func (in *Internal) State(id string, init func() interface{}) interface{} {
+	if state, ok := in.States[id]; ok {
+		return state
+	}
+	state := init()
+	in.States[id] = state
+	return state
+}
+
+// StateID builds the state store key of a component from its kind and
+// position within the current window.
+func (in *Internal) StateID(kind string, x, y int) string {
+	return fmt.Sprintf("%s/%s@%d,%d", in.GetContext("").WindowName, kind, x, y)
+}
+
+// QueueOverlay schedules fn to run during the next Update call, after
+// all regular components have been drawn, so whatever it draws stays on
+// top of them.
+func (in *Internal) QueueOverlay(fn func()) {
+	in.overlays = append(in.overlays, fn)
+}
+
+// ScreenBlock resets and returns the screen block targeting where, used
+// by components rendered at absolute positions.
+func (in *Internal) ScreenBlock(where *gocv.Mat) *Block {
+	in.watchdogFrameStarted()
+	in.Screen.Where = where
+	in.Screen.Rect = Rect{}
+	in.Screen.Fill = Rect{}
+	in.Screen.Anchor = Point{}
+	in.Screen.Padding = 0
+	return in.Screen
+}
+
+// TopBlock returns the block at the top of the layout stack.
+func (in *Internal) TopBlock() *Block {
+	if in.StackCount < 0 {
+		in.Fail(ErrStackMismatch, "", "using a component that requires a begin*()/end*() block without calling begin*()")
+		return in.Screen
+	}
+	return in.Stack[in.StackCount]
+}
+
+// PushBlock makes room for a new block at the top of the layout stack,
+// growing it as needed. The blocks are reused across frames.
+func (in *Internal) PushBlock() *Block {
+	in.StackCount++
+	if in.StackCount >= len(in.Stack) {
+		in.Stack = append(in.Stack, &Block{})
+	}
+	return in.Stack[in.StackCount]
+}
+
+// PopBlock removes and returns the block at the top of the layout stack.
+func (in *Internal) PopBlock() *Block {
+	if in.StackCount < 0 {
+		in.Fail(ErrStackMismatch, "", "calling end*() without an associated begin*()")
+		return in.Screen
+	}
+	block := in.Stack[in.StackCount]
+	in.StackCount--
+	return block
+}
+
+// BlockStackEmpty reports whether all begin*() calls have been matched
+// by an end*() call.
+func (in *Internal) BlockStackEmpty() bool {
+	return in.StackCount == -1
+}
+
+// BeginBlock opens a new layout block of the given type at (x, y).
+func (in *Internal) BeginBlock(where *gocv.Mat, x, y, width, height, padding, blockType int) {
+	in.watchdogFrameStarted()
+	block := in.PushBlock()
+	block.Where = where
+	block.Rect = Rect{x, y, width, height}
+	block.Fill = Rect{x, y, 0, 0}
+	block.Anchor = Point{x, y}
+	block.Padding = padding
+	block.Type = blockType
+	block.lineHeight = 0
+}
+
+// EndBlock closes the block at the top of the stack and flows its
+// occupied size into the parent block, if any.
+func (in *Internal) EndBlock() {
+	depth := in.StackCount
+	block := in.PopBlock()
+	in.finishStretches(depth, block)
+	if in.layoutDebug {
+		in.renderLayoutDebug(block)
+	}
+	if !in.BlockStackEmpty() {
+		height := block.Rect.Height
+		if block.Type == WRAP {
+			// Wrap blocks are sized by their content, not up front.
+			height = block.Fill.Height
+		}
+		in.UpdateLayoutFlow(in.TopBlock(), Size{block.Rect.Width, height})
+	}
+}
+
+// UpdateLayoutFlow advances the anchor of block after a component of
+// childSize has been laid out inside it. Pending size constraints (see
+// MinSize, MaxSize) clamp the space the component occupies in the flow.
+func (in *Internal) UpdateLayoutFlow(block *Block, childSize Size) {
+	childSize = in.clampChildSize(childSize)
+	if block.Type == ROW {
+		size := childSize.Width + block.Padding
+		block.Anchor.X += size
+		block.Fill.Width += size
+		if childSize.Height > block.Fill.Height {
+			block.Fill.Height = childSize.Height
+		}
+	} else if block.Type == WRAP {
+		size := childSize.Width + block.Padding
+		block.Anchor.X += size
+		if block.Anchor.X-block.Rect.X > block.Fill.Width {
+			block.Fill.Width = block.Anchor.X - block.Rect.X
+		}
+		if childSize.Height > block.lineHeight {
+			block.lineHeight = childSize.Height
+		}
+		lineBottom := block.Anchor.Y - block.Rect.Y + block.lineHeight
+		if lineBottom > block.Fill.Height {
+			block.Fill.Height = lineBottom
+		}
+		// Wrap to the next line once the block width is exceeded.
+		if block.Anchor.X >= block.Rect.X+block.Rect.Width {
+			block.Anchor.X = block.Rect.X
+			block.Anchor.Y += block.lineHeight + block.Padding
+			block.lineHeight = 0
+		}
+	} else if block.Type == COLUMN {
+		size := childSize.Height + block.Padding
+		block.Anchor.Y += size
+		block.Fill.Height += size
+		if childSize.Width > block.Fill.Width {
+			block.Fill.Width = childSize.Width
+		}
+	}
+}
+
+// Update resets the per-frame state of the context of windowName, i.e.
+// mouse click events and the layout stack, and flushes queued overlays.
+func (in *Internal) Update(windowName string) {
+	context := in.GetContext(windowName)
+	in.Clock.tick()
+
+	for _, fn := range in.overlays {
+		fn()
+	}
+	in.overlays = in.overlays[:0]
+
+	in.checkWatchdog()
+
+	if in.stepMode {
+		in.renderStepOverlay(context)
+	}
+
+	if in.describing {
+		in.lastDescriptions = in.descriptions
+		in.descriptions = nil
+	}
+
+	in.lastHitRegions, in.hitRegions = in.hitRegions, in.lastHitRegions[:0]
+
+	for _, button := range context.Mouse.Buttons {
+		button.reset()
+	}
+	context.Mouse.AnyButton.reset()
+	context.Mouse.Wheel = 0
+
+	in.modalActive = in.modalSeen
+	in.modalSeen = false
+
+	in.activeOverlayRects = append(in.activeOverlayRects[:0], in.overlayRects...)
+	in.overlayRects = in.overlayRects[:0]
+
+	in.nextMinSize = Size{}
+	in.nextMaxSize = Size{}
+
+	in.Screen.reset()
+
+	if !in.BlockStackEmpty() {
+		in.Fail(ErrStackMismatch, "", "calling Update() before finishing all begin*()/end*() calls")
+		in.StackCount = -1
+	}
+}
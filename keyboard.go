@@ -0,0 +1,89 @@
+package gocvui
+
+import (
+	"time"
+)
+
+// keyRepeatGrace is how long a key keeps counting as held after its
+// last waitKey report. OpenCV delivers key presses and autorepeats but
+// no release events, so a release is inferred once the repeats stop.
+const keyRepeatGrace = 600 * time.Millisecond
+
+// keyState tracks one key across frames.
+type keyState struct {
+	lastSeen     time.Duration
+	held         bool
+	justPressed  bool
+	justReleased bool
+}
+
+// Key reports whether the key with the given waitKey code counts as
+// held this frame. Because the underlying waitKey loop only reports
+// presses and autorepeats, "held" means the key was seen recently
+// enough that its autorepeat stream has not gone quiet yet.
+func Key(code int) bool {
+	if state, ok := internal.keys[code]; ok {
+		return state.held
+	}
+	return false
+}
+
+// KeyDown reports whether the key started being held this frame.
+func KeyDown(code int) bool {
+	if state, ok := internal.keys[code]; ok {
+		return state.justPressed
+	}
+	return false
+}
+
+// KeyUp reports whether the key stopped being held this frame, i.e.
+// its autorepeat stream went quiet.
+func KeyUp(code int) bool {
+	if state, ok := internal.keys[code]; ok {
+		return state.justReleased
+	}
+	return false
+}
+
+// TextInput returns the printable text typed this frame — at most one
+// character, since waitKey reports a single key per pump — or the empty
+// string. Text fields append it to their buffer each frame.
+func TextInput() string {
+	return internal.textInput
+}
+
+// updateKeyboard folds the key reported by the latest waitKey pump
+// (or -1) into the per-key state. Keyboard events arrive per process,
+// not per window, so the state is shared by every watched window.
+func (in *Internal) updateKeyboard(code int) {
+	now := in.Clock.Now()
+	in.textInput = ""
+
+	for _, state := range in.keys {
+		state.justPressed = false
+		state.justReleased = false
+		if state.held && now-state.lastSeen > keyRepeatGrace {
+			state.held = false
+			state.justReleased = true
+		}
+	}
+
+	if code < 0 {
+		return
+	}
+	if in.keys == nil {
+		in.keys = map[int]*keyState{}
+	}
+	state, ok := in.keys[code]
+	if !ok {
+		state = &keyState{}
+		in.keys[code] = state
+	}
+	state.justPressed = !state.held
+	state.held = true
+	state.lastSeen = now
+
+	if code >= 32 && code <= 126 {
+		in.textInput = string(rune(code))
+	}
+}
@@ -0,0 +1,120 @@
+package gocvui
+
+import (
+	"fmt"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+// knobSweep is the angular range of a knob, in radians: a 270 degree
+// sweep starting at the lower left.
+const (
+	knobSweep      = 1.5 * math.Pi
+	knobStartAngle = 0.75 * math.Pi
+	knobDragRange  = 200.0
+)
+
+// knobDrag tracks an in-flight knob drag across frames.
+type knobDrag struct {
+	Active     bool
+	StartY     int
+	StartValue float64
+}
+
+// Knob renders a rotary dial centered at (x, y), bound to value within
+// the range of params — a compact alternative to trackbars for dense
+// parameter panels. The knob is turned by dragging vertically;
+// TRACKBAR_DISCRETE in the options snaps to Step detents and
+// TRACKBAR_SMOOTH filters the drag. Returns true when the value
+// changed this frame.
+func Knob(frame *gocv.Mat, x, y, radius int, value *float64, params TrackbarParams) bool {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	state := internal.State(internal.StateID("knob", x, y), func() interface{} {
+		return &knobDrag{}
+	}).(*knobDrag)
+
+	hitArea := Rect{x - radius, y - radius, 2 * radius, 2 * radius}
+	status := internal.IArea(hitArea.X, hitArea.Y, hitArea.Width, hitArea.Height)
+
+	if !state.Active && status == DOWN && mouse.AnyButton.JustPressed {
+		state.Active = true
+		state.StartY = mouse.Position.Y
+		state.StartValue = *value
+	}
+	if state.Active && !mouse.AnyButton.Pressed {
+		state.Active = false
+	}
+
+	changed := false
+	if state.Active {
+		span := params.Max - params.Min
+		newValue := state.StartValue + float64(state.StartY-mouse.Position.Y)*span/knobDragRange
+		newValue = math.Max(params.Min, math.Min(params.Max, newValue))
+		if params.Options&TRACKBAR_SMOOTH != 0 {
+			newValue = internal.SmoothDrag(internal.StateID("knob.smooth", x, y), newValue, *value)
+		}
+		if params.Options&TRACKBAR_DISCRETE != 0 && params.Step != 0 {
+			if params.Options&TRACKBAR_SMOOTH != 0 {
+				newValue = snapWithHysteresis(newValue, *value, params.Step)
+			} else {
+				newValue = math.Round(newValue/params.Step) * params.Step
+			}
+		}
+		if newValue != *value {
+			*value = newValue
+			changed = true
+		}
+	}
+
+	renderKnob(block, x, y, radius, *value, params, status != OUT || state.Active)
+	return changed
+}
+
+// renderKnob draws the dial face, its detent ticks and the pointer of
+// the current value.
+func renderKnob(block *Block, x, y, radius int, value float64, params TrackbarParams, hot bool) {
+	center := Point{x, y}
+	render.circle(block.Where, center, radius, ColorInset, CVUI_FILLED)
+
+	border := ColorBorder
+	if hot {
+		border = theme.CheckboxBorderOver
+	}
+	render.circle(block.Where, center, radius, border, 1)
+
+	if params.Options&TRACKBAR_DISCRETE != 0 && params.Step > 0 {
+		for v := params.Min; v <= params.Max; v += params.Step {
+			angle := knobAngle(v, params)
+			outer := Point{x + int(float64(radius+3)*math.Cos(angle)), y + int(float64(radius+3)*math.Sin(angle))}
+			inner := Point{x + int(float64(radius)*math.Cos(angle)), y + int(float64(radius)*math.Sin(angle))}
+			render.line(block.Where, inner, outer, ColorDimText)
+		}
+	}
+
+	angle := knobAngle(value, params)
+	tip := Point{x + int(float64(radius-3)*math.Cos(angle)), y + int(float64(radius-3)*math.Sin(angle))}
+	pointer := theme.TextColor
+	if hot {
+		pointer = theme.CheckboxCheck
+	}
+	render.line(block.Where, Point{x, y}, tip, pointer)
+
+	if params.Options&TRACKBAR_HIDE_VALUE_LABEL == 0 {
+		text := fmt.Sprintf(params.LabelFormat, value)
+		size := render.GetTextSize(text, 0.3)
+		render.putText(block.Where, text, Point{x - size.Width/2, y + radius + size.Height + 6}, 0.3, theme.TextColor)
+	}
+}
+
+// knobAngle maps a value within params to its pointer angle.
+func knobAngle(value float64, params TrackbarParams) float64 {
+	span := params.Max - params.Min
+	if span <= 0 {
+		return knobStartAngle
+	}
+	ratio := (value - params.Min) / span
+	return knobStartAngle + ratio*knobSweep
+}
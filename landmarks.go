@@ -0,0 +1,99 @@
+package gocvui
+
+import (
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+// FaceResult is one detector output to visualize: a bounding box, an
+// optional set of landmark points (5-point or 68-point layouts both
+// work) and a confidence score.
+type FaceResult struct {
+	Box        Rect
+	Landmarks  []Point
+	Confidence float64
+}
+
+// LandmarkOptions tunes how Landmarks draws its overlay.
+type LandmarkOptions struct {
+	// BoxColor and PointColor style the drawing; zero values fall
+	// back to the theme highlight and text colors.
+	BoxColor   uint32
+	PointColor uint32
+	// PointRadius is the landmark dot radius; zero means 1.
+	PointRadius int
+	// HideConfidence omits the confidence labels.
+	HideConfidence bool
+	// Toggles enables the per-face visibility checkboxes at the top
+	// left corner of each box.
+	Toggles bool
+}
+
+// landmarksState remembers which faces the user has hidden through the
+// visibility toggles.
+type landmarksState struct {
+	Hidden map[int]bool
+}
+
+// Landmarks draws boxes, landmark points and confidence labels for
+// detector outputs like face detection results — a ready-made
+// visualization so applications stop hand-rolling it. With
+// opts.Toggles set, each face gets a small toggle to hide its overlay.
+func Landmarks(frame *gocv.Mat, faces []FaceResult, opts LandmarkOptions) {
+	block := internal.ScreenBlock(frame)
+
+	state := internal.State(internal.StateID("landmarks", 0, 0), func() interface{} {
+		return &landmarksState{Hidden: map[int]bool{}}
+	}).(*landmarksState)
+
+	boxColor := opts.BoxColor
+	if boxColor == 0 {
+		boxColor = theme.CheckboxCheck
+	}
+	pointColor := opts.PointColor
+	if pointColor == 0 {
+		pointColor = theme.TextColor
+	}
+	radius := opts.PointRadius
+	if radius <= 0 {
+		radius = 1
+	}
+
+	for i, face := range faces {
+		if opts.Toggles && renderLandmarkToggle(block, face.Box, !state.Hidden[i]) {
+			state.Hidden[i] = !state.Hidden[i]
+		}
+		if state.Hidden[i] {
+			continue
+		}
+
+		render.rectangle(block.Where, face.Box, boxColor, 1)
+		for _, point := range face.Landmarks {
+			render.circle(block.Where, point, radius, pointColor, CVUI_FILLED)
+		}
+
+		if !opts.HideConfidence {
+			text := fmt.Sprintf("%.2f", face.Confidence)
+			render.putText(block.Where, text, Point{face.Box.X, face.Box.Y - 4}, 0.3, boxColor)
+		}
+	}
+}
+
+// renderLandmarkToggle draws the small visibility box at the top-left
+// corner of a face box and reports a click on it.
+func renderLandmarkToggle(block *Block, box Rect, visible bool) bool {
+	toggle := Rect{box.X + 2, box.Y + 2, 9, 9}
+	status := internal.IArea(toggle.X, toggle.Y, toggle.Width, toggle.Height)
+
+	render.rectangle(block.Where, toggle, ColorInset, CVUI_FILLED)
+	border := ColorBorder
+	if status != OUT {
+		border = theme.CheckboxBorderOver
+	}
+	render.rectangle(block.Where, toggle, border, 1)
+	if visible {
+		render.rectangle(block.Where, Rect{toggle.X + 2, toggle.Y + 2, 5, 5}, theme.CheckboxCheck, CVUI_FILLED)
+	}
+	return status == CLICK
+}
@@ -0,0 +1,57 @@
+package gocvui
+
+// Colors of the layout debug overlay: block rects, the space their
+// children actually fill, component rects and padding gaps.
+const (
+	layoutDebugBlockColor   = 0x40C040
+	layoutDebugFillColor    = 0xC0A000
+	layoutDebugItemColor    = 0x4080C0
+	layoutDebugPaddingColor = 0x803030
+)
+
+// SetLayoutDebug toggles the layout debug overlay: every begin*/end*
+// block is outlined with its declared Rect, the area its children fill,
+// its anchor and the trailing padding gap, and every component is
+// outlined individually. The overlay draws in low-key colors on top of
+// the regular rendering, making misaligned or overflowing layouts easy
+// to spot. Off by default.
+func SetLayoutDebug(enabled bool) {
+	internal.layoutDebug = enabled
+}
+
+// renderLayoutDebug outlines a block that has just been closed: its
+// Rect, its Fill, the anchor position and the last padding gap.
+func (in *Internal) renderLayoutDebug(block *Block) {
+	if block.Rect.Width > 0 && block.Rect.Height > 0 {
+		render.rectangle(block.Where, block.Rect, layoutDebugBlockColor, 1)
+	}
+
+	fill := Rect{block.Rect.X, block.Rect.Y, block.Fill.Width, block.Fill.Height}
+	if fill.Width > 0 && fill.Height > 0 {
+		render.rectangle(block.Where, fill, layoutDebugFillColor, 1)
+	}
+
+	anchor := block.Anchor
+	render.line(block.Where, Point{anchor.X - 3, anchor.Y}, Point{anchor.X + 3, anchor.Y}, ColorHighlight)
+	render.line(block.Where, Point{anchor.X, anchor.Y - 3}, Point{anchor.X, anchor.Y + 3}, ColorHighlight)
+
+	if block.Padding > 0 {
+		gap := Rect{anchor.X - block.Padding, block.Rect.Y, block.Padding, fill.Height}
+		if block.Type == COLUMN {
+			gap = Rect{block.Rect.X, anchor.Y - block.Padding, fill.Width, block.Padding}
+		}
+		render.rectangle(block.Where, gap, layoutDebugPaddingColor, 1)
+	}
+}
+
+// renderItemDebug outlines a single component as it is described.
+func (in *Internal) renderItemDebug(rect Rect) {
+	where := in.Screen.Where
+	if !in.BlockStackEmpty() {
+		where = in.TopBlock().Where
+	}
+	if where == nil || rect.Width <= 0 || rect.Height <= 0 {
+		return
+	}
+	render.rectangle(where, rect, layoutDebugItemColor, 1)
+}
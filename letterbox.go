@@ -0,0 +1,64 @@
+package gocvui
+
+import (
+	"image"
+
+	"gocv.io/x/gocv"
+)
+
+// Transform maps between the coordinates of a letterboxed source frame
+// and the UI frame it was drawn into.
+type Transform struct {
+	// Scale is the factor the source was scaled by.
+	Scale float64
+	// OffsetX and OffsetY position the scaled source in the frame.
+	OffsetX int
+	OffsetY int
+}
+
+// ToFrame maps a source coordinate to the UI frame.
+func (t Transform) ToFrame(p Point) Point {
+	return Point{int(float64(p.X)*t.Scale) + t.OffsetX, int(float64(p.Y)*t.Scale) + t.OffsetY}
+}
+
+// ToSource maps a UI frame coordinate back to the source frame, e.g.
+// the mouse position to a pixel of the capture.
+func (t Transform) ToSource(p Point) Point {
+	if t.Scale == 0 {
+		return p
+	}
+	return Point{int(float64(p.X-t.OffsetX) / t.Scale), int(float64(p.Y-t.OffsetY) / t.Scale)}
+}
+
+// Letterbox scales and centers src into dst preserving its aspect
+// ratio, filling the bars with bg, and returns the Transform for
+// mapping detections and mouse positions between the two — the usual
+// viewer boilerplate in one call.
+func Letterbox(dst *gocv.Mat, src gocv.Mat, bg uint32) Transform {
+	if src.Empty() || src.Cols() == 0 || src.Rows() == 0 || dst.Cols() == 0 || dst.Rows() == 0 {
+		return Transform{Scale: 1}
+	}
+
+	scaleX := float64(dst.Cols()) / float64(src.Cols())
+	scaleY := float64(dst.Rows()) / float64(src.Rows())
+	scale := scaleX
+	if scaleY < scale {
+		scale = scaleY
+	}
+
+	width := int(float64(src.Cols()) * scale)
+	height := int(float64(src.Rows()) * scale)
+	transform := Transform{
+		Scale:   scale,
+		OffsetX: (dst.Cols() - width) / 2,
+		OffsetY: (dst.Rows() - height) / 2,
+	}
+
+	render.rectangle(dst, Rect{0, 0, dst.Cols(), dst.Rows()}, bg, CVUI_FILLED)
+
+	region := dst.Region(image.Rect(transform.OffsetX, transform.OffsetY, transform.OffsetX+width, transform.OffsetY+height))
+	gocv.Resize(src, &region, image.Pt(width, height), 0, 0, gocv.InterpolationArea)
+	region.Close()
+
+	return transform
+}
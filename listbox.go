@@ -0,0 +1,77 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const listBoxRowHeight = 18
+
+// listBoxState keeps the scroll offset of a ListBox between frames.
+type listBoxState struct {
+	Scroll int
+}
+
+// ListBox renders a scrollable list of items bound to the index at
+// *selected. Rows highlight under the cursor and clicking one selects
+// it; the arrow bands at the top and bottom scroll long lists. Returns
+// true when the selection changes.
+func ListBox(frame *gocv.Mat, x, y, width, height int, items []string, selected *int) bool {
+	block := internal.ScreenBlock(frame)
+	area := Rect{x, y, width, height}
+
+	state := internal.State(internal.StateID("listbox", x, y), func() interface{} {
+		return &listBoxState{}
+	}).(*listBoxState)
+
+	render.rectangle(block.Where, area, 0x292929, CVUI_FILLED)
+	render.rectangle(block.Where, area, 0x45494A, 1)
+
+	visible := height / listBoxRowHeight
+	scrollable := len(items) > visible
+	rowsY := y
+	if scrollable {
+		visible = (height - 2*dropdownArrowBand) / listBoxRowHeight
+		if internal.IArea(x, y, width, dropdownArrowBand) == CLICK && state.Scroll > 0 {
+			state.Scroll--
+		}
+		if internal.IArea(x, y+height-dropdownArrowBand, width, dropdownArrowBand) == CLICK && state.Scroll < len(items)-visible {
+			state.Scroll++
+		}
+		renderDropdownArrow(block, Rect{x, y, width, dropdownArrowBand}, true, state.Scroll > 0)
+		renderDropdownArrow(block, Rect{x, y + height - dropdownArrowBand, width, dropdownArrowBand}, false, state.Scroll < len(items)-visible)
+		rowsY += dropdownArrowBand
+	}
+	if state.Scroll > len(items)-visible {
+		state.Scroll = len(items) - visible
+	}
+	if state.Scroll < 0 {
+		state.Scroll = 0
+	}
+
+	changed := false
+	for i := 0; i < visible && state.Scroll+i < len(items); i++ {
+		index := state.Scroll + i
+		row := Rect{x, rowsY + i*listBoxRowHeight, width, listBoxRowHeight}
+		status := internal.IArea(row.X, row.Y, row.Width, row.Height)
+
+		if status == CLICK && *selected != index {
+			*selected = index
+			changed = true
+		}
+
+		if index == *selected {
+			render.rectangle(block.Where, row, 0x313131, CVUI_FILLED)
+			render.rectangle(block.Where, row, 0x45494A, 1)
+		} else if status == OVER || status == DOWN {
+			render.rectangle(block.Where, row, 0x3E4348, CVUI_FILLED)
+		}
+
+		hexColor := theme.TextColor
+		if index == *selected {
+			hexColor = theme.CheckboxCheck
+		}
+		size := render.GetTextSize(items[index], 0.4)
+		render.putText(block.Where, items[index], Point{row.X + 6, row.Y + row.Height/2 + size.Height/2}, 0.4, hexColor)
+	}
+	return changed
+}
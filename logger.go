@@ -0,0 +1,32 @@
+package gocvui
+
+// Logger is the minimal interface the library reports diagnostics
+// through: non-fatal problems such as duplicate watched windows,
+// degenerate component parameters or deprecated calls. *slog.Logger
+// satisfies it directly.
+type Logger interface {
+	Warn(msg string, args ...interface{})
+}
+
+// SetLogger installs the diagnostics logger. Without one, diagnostics
+// are dropped.
+func SetLogger(l Logger) {
+	internal.logger = l
+}
+
+// Warn reports a diagnostic through the installed logger.
+func (in *Internal) Warn(msg string, args ...interface{}) {
+	if in.logger != nil {
+		in.logger.Warn(msg, args...)
+	}
+}
+
+// WarnOnce reports a diagnostic only the first time key is seen, so
+// per-frame code does not flood the log.
+func (in *Internal) WarnOnce(key, msg string, args ...interface{}) {
+	if in.warned[key] {
+		return
+	}
+	in.warned[key] = true
+	in.Warn(msg, args...)
+}
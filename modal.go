@@ -0,0 +1,41 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	modalTitleBarHeight = 20
+	modalPadding        = 10
+	modalDimFactor      = 0.4
+)
+
+// BeginModal opens a modal dialog: the frame rendered so far is dimmed,
+// a window panel of the given size is centered on top of it, and every
+// widget outside the modal stops reacting to the mouse until EndModal
+// of a later frame is no longer reached. Widgets rendered between
+// BeginModal and EndModal flow top to bottom inside the panel.
+func BeginModal(frame *gocv.Mat, title string, width, height int) {
+	frame.MultiplyFloat(modalDimFactor)
+
+	x := (frame.Cols() - width) / 2
+	y := (frame.Rows() - height) / 2
+
+	block := internal.ScreenBlock(frame)
+	internal.Window(block, x, y, width, height, title, false)
+
+	internal.modalRect = Rect{x, y, width, height}
+	internal.modalSeen = true
+	internal.inModal = true
+
+	content := Rect{x + modalPadding, y + modalTitleBarHeight + modalPadding, width - 2*modalPadding, height - modalTitleBarHeight - 2*modalPadding}
+	internal.BeginBlock(frame, content.X, content.Y, content.Width, content.Height, modalPadding, COLUMN)
+}
+
+// EndModal closes the block opened by BeginModal. The modal stays open
+// as long as BeginModal keeps being called every frame; skip the call
+// to dismiss it.
+func EndModal() {
+	internal.EndBlock()
+	internal.inModal = false
+}
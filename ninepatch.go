@@ -0,0 +1,57 @@
+package gocvui
+
+import (
+	"image"
+
+	"gocv.io/x/gocv"
+)
+
+// Insets describes the fixed border of a nine-patch image, i.e. how
+// many pixels on each side must not be stretched.
+type Insets struct {
+	Top    int
+	Right  int
+	Bottom int
+	Left   int
+}
+
+// UniformInsets returns insets with the same size on all four sides.
+func UniformInsets(size int) Insets {
+	return Insets{Top: size, Right: size, Bottom: size, Left: size}
+}
+
+// NinePatch renders img into rect by keeping its four corners at their
+// original size while stretching the edges and center, so skinned
+// panels and buttons scale to any size without distorting their
+// borders. The insets select the corner regions of the source image.
+func NinePatch(frame *gocv.Mat, rect Rect, img gocv.Mat, insets Insets) {
+	srcX := []int{0, insets.Left, img.Cols() - insets.Right, img.Cols()}
+	srcY := []int{0, insets.Top, img.Rows() - insets.Bottom, img.Rows()}
+	dstX := []int{rect.X, rect.X + insets.Left, rect.X + rect.Width - insets.Right, rect.X + rect.Width}
+	dstY := []int{rect.Y, rect.Y + insets.Top, rect.Y + rect.Height - insets.Bottom, rect.Y + rect.Height}
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			srcW, srcH := srcX[i+1]-srcX[i], srcY[j+1]-srcY[j]
+			dstW, dstH := dstX[i+1]-dstX[i], dstY[j+1]-dstY[j]
+			if srcW <= 0 || srcH <= 0 || dstW <= 0 || dstH <= 0 {
+				continue
+			}
+
+			src := img.Region(image.Rect(srcX[i], srcY[j], srcX[i+1], srcY[j+1]))
+			dst := frame.Region(image.Rect(dstX[i], dstY[j], dstX[i+1], dstY[j+1]))
+
+			if srcW == dstW && srcH == dstH {
+				src.CopyTo(&dst)
+			} else {
+				scaled := gocv.NewMat()
+				gocv.Resize(src, &scaled, image.Pt(dstW, dstH), 0, 0, gocv.InterpolationLinear)
+				scaled.CopyTo(&dst)
+				scaled.Close()
+			}
+
+			src.Close()
+			dst.Close()
+		}
+	}
+}
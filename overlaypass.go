@@ -0,0 +1,44 @@
+package gocvui
+
+// OverlayPass renders draw as an overlay: it is deferred to the end of
+// the frame so it paints on top of every base widget, and the given
+// rect claims mouse priority — base widgets under it stop reacting
+// while the cursor is inside, no matter where in the frame they render.
+// Dropdown lists, context menus and tooltips use this to both overdraw
+// and capture input correctly:
+//
+//	if open {
+//		gocvui.OverlayPass(menuRect, func() {
+//			// IArea/widget calls in here still see this frame's
+//			// mouse events and win over anything underneath.
+//		})
+//	}
+//
+// Several passes stack in call order, later ones drawing on top.
+func OverlayPass(rect Rect, draw func()) {
+	internal.overlayRects = append(internal.overlayRects, rect)
+	internal.QueueOverlay(func() {
+		wasInOverlay := internal.inOverlay
+		internal.inOverlay = true
+		draw()
+		internal.inOverlay = wasInOverlay
+	})
+}
+
+// overlayAt reports whether p is inside an overlay rect claimed this
+// frame or the previous one. The previous frame's rects cover base
+// widgets that render before the overlay widget does, mirroring how
+// modals block interaction.
+func (in *Internal) overlayAt(p Point) bool {
+	for _, rect := range in.overlayRects {
+		if rect.Contains(p) {
+			return true
+		}
+	}
+	for _, rect := range in.activeOverlayRects {
+		if rect.Contains(p) {
+			return true
+		}
+	}
+	return false
+}
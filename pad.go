@@ -0,0 +1,58 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// Pad2D renders a rectangular pad mapping the cursor position to two
+// values, e.g. pan X/Y or a kernel anchor. While the left button is
+// held inside the pad, *valueX and *valueY follow the cursor, clamped
+// to their ranges. A crosshair marks the current values. Returns the
+// interaction state of the pad (OUT, OVER, DOWN or CLICK).
+func Pad2D(frame *gocv.Mat, x, y, width, height int, valueX, valueY *float64, minX, maxX, minY, maxY float64) int {
+	block := internal.ScreenBlock(frame)
+	area := Rect{x, y, width, height}
+	status := internal.IArea(x, y, width, height)
+
+	if status == DOWN || status == CLICK {
+		mouse := internal.GetContext("").Mouse
+		ratioX := float64(mouse.Position.X-area.X) / float64(area.Width-1)
+		ratioY := float64(mouse.Position.Y-area.Y) / float64(area.Height-1)
+		*valueX = clampFloat(minX+ratioX*(maxX-minX), minX, maxX)
+		*valueY = clampFloat(minY+ratioY*(maxY-minY), minY, maxY)
+	}
+
+	render.rectangle(block.Where, area, 0x292929, CVUI_FILLED)
+	border := uint32(0x45494A)
+	if status != OUT {
+		border = 0x626262
+	}
+	render.rectangle(block.Where, area, border, 1)
+
+	// Crosshair at the current values.
+	crossX := area.X + int(normalized(*valueX, minX, maxX)*float64(area.Width-1))
+	crossY := area.Y + int(normalized(*valueY, minY, maxY)*float64(area.Height-1))
+	render.line(block.Where, Point{area.X, crossY}, Point{area.X + area.Width - 1, crossY}, 0x565656)
+	render.line(block.Where, Point{crossX, area.Y}, Point{crossX, area.Y + area.Height - 1}, 0x565656)
+	render.line(block.Where, Point{crossX - 4, crossY}, Point{crossX + 4, crossY}, theme.CheckboxCheck)
+	render.line(block.Where, Point{crossX, crossY - 4}, Point{crossX, crossY + 4}, theme.CheckboxCheck)
+
+	return status
+}
+
+func clampFloat(value, min, max float64) float64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+func normalized(value, min, max float64) float64 {
+	if max == min {
+		return 0
+	}
+	return (clampFloat(value, min, max) - min) / (max - min)
+}
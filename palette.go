@@ -0,0 +1,48 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	paletteSwatchSize = 18
+	paletteSwatchGap  = 4
+	paletteColumns    = 8
+)
+
+// Palette renders a grid of predefined color swatches bound to the
+// index at *selected, a quick alternative to a full color picker.
+// Swatches highlight while hovered; clicking one selects it. Returns
+// true when the selection changes.
+func Palette(frame *gocv.Mat, x, y int, colors []uint32, selected *int) bool {
+	block := internal.ScreenBlock(frame)
+	changed := false
+
+	for i, hexColor := range colors {
+		column := i % paletteColumns
+		row := i / paletteColumns
+		swatch := Rect{
+			X:      x + column*(paletteSwatchSize+paletteSwatchGap),
+			Y:      y + row*(paletteSwatchSize+paletteSwatchGap),
+			Width:  paletteSwatchSize,
+			Height: paletteSwatchSize,
+		}
+
+		status := internal.IArea(swatch.X, swatch.Y, swatch.Width, swatch.Height)
+		if status == CLICK && *selected != i {
+			*selected = i
+			changed = true
+		}
+
+		render.rectangle(block.Where, swatch, hexColor, CVUI_FILLED)
+		switch {
+		case i == *selected:
+			render.rectangle(block.Where, Rect{swatch.X - 2, swatch.Y - 2, swatch.Width + 4, swatch.Height + 4}, theme.CheckboxCheck, 1)
+		case status == OVER || status == DOWN:
+			render.rectangle(block.Where, swatch, theme.TextColor, 1)
+		default:
+			render.rectangle(block.Where, swatch, 0x45494A, 1)
+		}
+	}
+	return changed
+}
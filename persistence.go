@@ -0,0 +1,57 @@
+package gocvui
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// PersistentState returns the cross-session state stored under id,
+// initializing it with init on first use. If state loaded by LoadState
+// carries the id, the loaded JSON is unmarshaled into the fresh value.
+// All live persistent states are written out by SaveState.
+func (in *Internal) PersistentState(id string, init func() interface{}) interface{} {
+	if state, ok := in.Persistent[id]; ok {
+		return state
+	}
+	state := init()
+	if raw, ok := in.persistentRaw[id]; ok {
+		json.Unmarshal(raw, state)
+		delete(in.persistentRaw, id)
+	}
+	in.Persistent[id] = state
+	return state
+}
+
+// SaveState writes all persistent widget state (e.g. table column
+// layouts) to path as JSON, so it can be restored by LoadState in a
+// future session.
+func SaveState(path string) error {
+	states := map[string]json.RawMessage{}
+	for id, raw := range internal.persistentRaw {
+		states[id] = raw
+	}
+	for id, state := range internal.Persistent {
+		raw, err := json.Marshal(state)
+		if err != nil {
+			return err
+		}
+		states[id] = raw
+	}
+
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// LoadState reads widget state previously written by SaveState. It
+// should be called before the widgets using the state are rendered for
+// the first time.
+func LoadState(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &internal.persistentRaw)
+}
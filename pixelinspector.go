@@ -0,0 +1,101 @@
+package gocvui
+
+import (
+	"fmt"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	pixelInspectorWidth     = 150
+	pixelInspectorRowHeight = 13
+	pixelInspectorCrosshair = 8
+	pixelInspectorOffset    = 12
+)
+
+// PixelInspector shows a crosshair and a small overlay box with the
+// coordinates and the BGR and HSV values of the pixel under the cursor
+// while it hovers the area rect, reading from source — typically the
+// clean capture the UI is drawn over. Coordinates are relative to the
+// area, so it can sit anywhere in the frame.
+func PixelInspector(frame *gocv.Mat, area Rect, source gocv.Mat) {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	if !area.Contains(mouse.Position) {
+		return
+	}
+
+	col := mouse.Position.X - area.X
+	row := mouse.Position.Y - area.Y
+	if row >= source.Rows() || col >= source.Cols() {
+		return
+	}
+
+	pixel := source.GetVecbAt(row, col)
+	if len(pixel) < 3 {
+		return
+	}
+	b, g, r := pixel[0], pixel[1], pixel[2]
+	h, s, v := bgrToHsv(b, g, r)
+
+	cursor := mouse.Position
+	lines := []string{
+		fmt.Sprintf("x:%d y:%d", col, row),
+		fmt.Sprintf("BGR %d %d %d", b, g, r),
+		fmt.Sprintf("HSV %d %d %d", h, s, v),
+	}
+
+	internal.QueueOverlay(func() {
+		render.line(block.Where, Point{cursor.X - pixelInspectorCrosshair, cursor.Y}, Point{cursor.X + pixelInspectorCrosshair, cursor.Y}, ColorHighlight)
+		render.line(block.Where, Point{cursor.X, cursor.Y - pixelInspectorCrosshair}, Point{cursor.X, cursor.Y + pixelInspectorCrosshair}, ColorHighlight)
+
+		box := Rect{cursor.X + pixelInspectorOffset, cursor.Y + pixelInspectorOffset, pixelInspectorWidth, len(lines)*pixelInspectorRowHeight + 8}
+		if box.X+box.Width > block.Where.Cols() {
+			box.X = cursor.X - pixelInspectorOffset - box.Width
+		}
+		if box.Y+box.Height > block.Where.Rows() {
+			box.Y = cursor.Y - pixelInspectorOffset - box.Height
+		}
+
+		render.rectangle(block.Where, box, ColorPanel, CVUI_FILLED)
+		render.rectangle(block.Where, box, ColorBorder, 1)
+		swatch := Rect{box.X + box.Width - 16, box.Y + 4, 12, 12}
+		render.rectangle(block.Where, swatch, uint32(r)<<16|uint32(g)<<8|uint32(b), CVUI_FILLED)
+		render.rectangle(block.Where, swatch, ColorBorder, 1)
+
+		for i, line := range lines {
+			render.putText(block.Where, line, Point{box.X + 5, box.Y + (i+1)*pixelInspectorRowHeight}, 0.3, theme.TextColor)
+		}
+	})
+}
+
+// bgrToHsv converts one 8-bit BGR pixel to the OpenCV HSV ranges
+// (H 0..179, S and V 0..255).
+func bgrToHsv(b, g, r uint8) (int, int, int) {
+	rf, gf, bf := float64(r)/255, float64(g)/255, float64(b)/255
+	max := math.Max(rf, math.Max(gf, bf))
+	min := math.Min(rf, math.Min(gf, bf))
+	delta := max - min
+
+	h := 0.0
+	switch {
+	case delta == 0:
+	case max == rf:
+		h = 60 * math.Mod((gf-bf)/delta, 6)
+	case max == gf:
+		h = 60 * ((bf-rf)/delta + 2)
+	default:
+		h = 60 * ((rf-gf)/delta + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+
+	s := 0.0
+	if max > 0 {
+		s = delta / max
+	}
+	return int(h / 2), int(s * 255), int(max * 255)
+}
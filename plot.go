@@ -0,0 +1,224 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	plotLegendRowHeight = 16
+	plotLegendSwatch    = 10
+	plotMarginLeft      = 36
+	plotMarginBottom    = 18
+	plotDefaultTicks    = 4
+	plotTickLength      = 3
+)
+
+// PlotSeries is one named line of data in a Plot.
+type PlotSeries struct {
+	Label  string
+	Color  uint32
+	Values []float64
+}
+
+// Plot is a multi-series chart. Unlike the simple components, a Plot is
+// an instance the application keeps around, so series data and per-plot
+// settings survive between frames.
+type Plot struct {
+	Series []PlotSeries
+
+	// XLabel and YLabel caption the axes.
+	XLabel string
+	YLabel string
+	// YMin and YMax fix the vertical range; while YMax <= YMin the
+	// plot autoscales to the visible series.
+	YMin float64
+	YMax float64
+	// Ticks is the number of tick marks per axis; zero means 4.
+	Ticks int
+}
+
+// NewPlot returns an empty plot.
+func NewPlot() *Plot {
+	return &Plot{}
+}
+
+// AddSeries appends a named series to the plot and returns the plot for
+// chaining.
+func (p *Plot) AddSeries(label string, hexColor uint32, values []float64) *Plot {
+	p.Series = append(p.Series, PlotSeries{Label: label, Color: hexColor, Values: values})
+	return p
+}
+
+// plotLegendState remembers which series the user has hidden through
+// the legend.
+type plotLegendState struct {
+	Hidden map[string]bool
+}
+
+// Render draws the plot at (x, y): axes with tick marks and labels on
+// the left and bottom, the series lines, and the legend. Legend
+// entries are clickable to show/hide individual series and highlight
+// their line while hovered.
+func (p *Plot) Render(frame *gocv.Mat, x, y, width, height int) {
+	block := internal.ScreenBlock(frame)
+	area := Rect{x, y, width, height}
+
+	state := internal.State(internal.StateID("plot.legend", x, y), func() interface{} {
+		return &plotLegendState{Hidden: map[string]bool{}}
+	}).(*plotLegendState)
+
+	render.rectangle(block.Where, area, 0x292929, CVUI_FILLED)
+	render.rectangle(block.Where, area, 0x45494A, 1)
+
+	min, max := p.valueRange(state)
+	points := 0
+	for _, series := range p.Series {
+		if !state.Hidden[series.Label] && len(series.Values) > points {
+			points = len(series.Values)
+		}
+	}
+
+	chart := Rect{area.X + plotMarginLeft, area.Y + 5, area.Width - plotMarginLeft - 5, area.Height - plotMarginBottom - 10}
+	p.renderAxes(block, area, chart, min, max, points)
+
+	hovered := p.renderLegend(block, area, state)
+
+	for i, series := range p.Series {
+		if state.Hidden[series.Label] || len(series.Values) < 2 {
+			continue
+		}
+		thickness := 1
+		if i == hovered {
+			thickness = 2
+		}
+		p.renderSeries(block, chart, series, min, max, thickness)
+	}
+}
+
+// valueRange returns the vertical range of the plot: the fixed
+// YMin/YMax when set, otherwise the shared scale across all visible
+// series.
+func (p *Plot) valueRange(state *plotLegendState) (float64, float64) {
+	if p.YMax > p.YMin {
+		return p.YMin, p.YMax
+	}
+
+	min, max := 0.0, 0.0
+	first := true
+	for _, series := range p.Series {
+		if state.Hidden[series.Label] || len(series.Values) == 0 {
+			continue
+		}
+		seriesMin, seriesMax := internal.FindMinMax(series.Values)
+		if first || seriesMin < min {
+			min = seriesMin
+		}
+		if first || seriesMax > max {
+			max = seriesMax
+		}
+		first = false
+	}
+	return min, max
+}
+
+// renderAxes draws the X and Y axis lines, tick marks with value
+// labels, and the axis captions.
+func (p *Plot) renderAxes(block *Block, area, chart Rect, min, max float64, points int) {
+	ticks := p.Ticks
+	if ticks <= 0 {
+		ticks = plotDefaultTicks
+	}
+
+	origin := Point{chart.X, chart.Y + chart.Height}
+	render.line(block.Where, Point{chart.X, chart.Y}, origin, ColorDimText)
+	render.line(block.Where, origin, Point{chart.X + chart.Width, origin.Y}, ColorDimText)
+
+	for i := 0; i <= ticks; i++ {
+		value := min + (max-min)*float64(i)/float64(ticks)
+		tickY := origin.Y - chart.Height*i/ticks
+		render.line(block.Where, Point{chart.X - plotTickLength, tickY}, Point{chart.X, tickY}, ColorDimText)
+
+		text := formatFloat(value)
+		size := render.GetTextSize(text, 0.3)
+		render.putText(block.Where, text, Point{chart.X - plotTickLength - size.Width - 2, tickY + size.Height/2}, 0.3, ColorDimText)
+	}
+
+	if points > 1 {
+		for i := 0; i <= ticks; i++ {
+			index := (points - 1) * i / ticks
+			tickX := chart.X + chart.Width*i/ticks
+			render.line(block.Where, Point{tickX, origin.Y}, Point{tickX, origin.Y + plotTickLength}, ColorDimText)
+
+			text := formatFloat(float64(index))
+			size := render.GetTextSize(text, 0.3)
+			render.putText(block.Where, text, Point{tickX - size.Width/2, origin.Y + plotTickLength + size.Height + 2}, 0.3, ColorDimText)
+		}
+	}
+
+	if p.YLabel != "" {
+		render.putText(block.Where, p.YLabel, Point{area.X + 3, chart.Y - 1}, 0.3, theme.TextColor)
+	}
+	if p.XLabel != "" {
+		size := render.GetTextSize(p.XLabel, 0.3)
+		render.putText(block.Where, p.XLabel, Point{chart.X + chart.Width - size.Width, area.Y + area.Height - 4}, 0.3, theme.TextColor)
+	}
+}
+
+// renderLegend draws the legend box, handles clicks toggling series
+// visibility and returns the index of the hovered entry, if any.
+func (p *Plot) renderLegend(block *Block, area Rect, state *plotLegendState) int {
+	widest := 0
+	for _, series := range p.Series {
+		if width := render.GetTextSize(series.Label, 0.3).Width; width > widest {
+			widest = width
+		}
+	}
+
+	legend := Rect{
+		X:      area.X + area.Width - widest - plotLegendSwatch - 14,
+		Y:      area.Y + 4,
+		Width:  widest + plotLegendSwatch + 10,
+		Height: len(p.Series) * plotLegendRowHeight,
+	}
+	render.rectangle(block.Where, legend, 0x313131, CVUI_FILLED)
+
+	hovered := -1
+	for i, series := range p.Series {
+		row := Rect{legend.X, legend.Y + i*plotLegendRowHeight, legend.Width, plotLegendRowHeight}
+		status := internal.IArea(row.X, row.Y, row.Width, row.Height)
+		if status == OVER || status == DOWN {
+			hovered = i
+			render.rectangle(block.Where, row, 0x3E4348, CVUI_FILLED)
+		}
+		if status == CLICK {
+			state.Hidden[series.Label] = !state.Hidden[series.Label]
+		}
+
+		swatch := Rect{row.X + 3, row.Y + (plotLegendRowHeight-plotLegendSwatch)/2, plotLegendSwatch, plotLegendSwatch}
+		hexColor := series.Color
+		labelColor := theme.TextColor
+		if state.Hidden[series.Label] {
+			hexColor = 0x565656
+			labelColor = 0x767676
+		}
+		render.rectangle(block.Where, swatch, hexColor, CVUI_FILLED)
+		size := render.GetTextSize(series.Label, 0.3)
+		render.putText(block.Where, series.Label, Point{swatch.X + plotLegendSwatch + 4, row.Y + row.Height/2 + size.Height/2}, 0.3, labelColor)
+	}
+	return hovered
+}
+
+func (p *Plot) renderSeries(block *Block, area Rect, series PlotSeries, min, max float64, thickness int) {
+	scale := max - min
+	if scale < 1e-10 {
+		scale = 1e-10
+	}
+
+	gap := float64(area.Width) / float64(len(series.Values)-1)
+	for i := 0; i < len(series.Values)-1; i++ {
+		x1 := float64(area.X) + float64(i)*gap
+		y1 := float64(area.Y+area.Height) - (series.Values[i]-min)/scale*float64(area.Height)
+		y2 := float64(area.Y+area.Height) - (series.Values[i+1]-min)/scale*float64(area.Height)
+		render.thickLine(block.Where, Point{int(x1), int(y1)}, Point{int(x1 + gap), int(y2)}, series.Color, thickness)
+	}
+}
@@ -0,0 +1,61 @@
+package gocvui
+
+import (
+	"sync"
+)
+
+// publishChannels holds one coalescing channel per topic name. Only
+// the map access is guarded; the handoff itself is channel-based.
+var (
+	publishMu       sync.Mutex
+	publishChannels = map[string]chan interface{}{}
+)
+
+// publishChannel returns the topic channel, creating it on first use.
+// Capacity one plus the drain in Publish gives latest-value semantics.
+func publishChannel(name string) chan interface{} {
+	publishMu.Lock()
+	defer publishMu.Unlock()
+	channel, ok := publishChannels[name]
+	if !ok {
+		channel = make(chan interface{}, 1)
+		publishChannels[name] = channel
+	}
+	return channel
+}
+
+// Publish hands a value to the UI goroutine under a topic name.
+// Detection and processing goroutines call it freely: values are
+// coalesced, so an unconsumed older value is dropped and Consume
+// always sees the latest one. Publish never blocks.
+func Publish(name string, value interface{}) {
+	channel := publishChannel(name)
+	for {
+		select {
+		case channel <- value:
+			return
+		default:
+			// The slot is full with a stale value; drop it and retry.
+			select {
+			case <-channel:
+			default:
+			}
+		}
+	}
+}
+
+// Consume returns the latest value published under the topic name, or
+// nil when nothing new arrived since the last call. Meant for the UI
+// goroutine, typically once per frame:
+//
+//	if v := gocvui.Consume("detections"); v != nil {
+//		detections = v.([]gocvui.Rect)
+//	}
+func Consume(name string) interface{} {
+	select {
+	case value := <-publishChannel(name):
+		return value
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,64 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const radioRowHeight = 20
+
+// RadioGroup renders a vertical group of radio buttons bound to the
+// index at *selected and returns true when the selection changes:
+//
+//	RadioGroup(frame, x, y, labels, &selected)
+//	RadioGroup(labels, &selected)               // inside a begin*/end* block
+func RadioGroup(theArgs ...interface{}) bool {
+	if frame, ok := theArgs[0].(*gocv.Mat); ok {
+		x, y := toInt(theArgs[1]), toInt(theArgs[2])
+		return internal.RadioGroup(internal.ScreenBlock(frame), x, y, theArgs[3].([]string), theArgs[4].(*int), false)
+	}
+
+	block := internal.TopBlock()
+	return internal.RadioGroup(block, block.Anchor.X, block.Anchor.Y, theArgs[0].([]string), theArgs[1].(*int), true)
+}
+
+// RadioGroup renders the radio buttons and handles their interaction.
+func (in *Internal) RadioGroup(block *Block, x, y int, labels []string, selected *int, updateLayout bool) bool {
+	changed := false
+	maxWidth := 0
+
+	for i, label := range labels {
+		rowY := y + i*radioRowHeight
+		size := render.GetTextSize(label, 0.4)
+		width := 15 + size.Width + 6
+		if width > maxWidth {
+			maxWidth = width
+		}
+
+		status := in.IArea(x, rowY, width, radioRowHeight)
+		if status == CLICK && *selected != i {
+			*selected = i
+			changed = true
+		}
+
+		render.Radio(block, status, Point{x + 7, rowY + radioRowHeight/2}, i == *selected)
+		render.putText(block.Where, label, Point{x + 21, rowY + radioRowHeight/2 + size.Height/2 - 1}, 0.4, theme.TextColor)
+	}
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{maxWidth, len(labels) * radioRowHeight})
+	}
+	return changed
+}
+
+// Radio renders the circular indicator of a single radio button.
+func (r *Render) Radio(block *Block, state int, center Point, checked bool) {
+	border := theme.CheckboxBorderOut
+	if state != OUT {
+		border = theme.CheckboxBorderOver
+	}
+	render.circle(block.Where, center, 7, theme.CheckboxFill, CVUI_FILLED)
+	render.circle(block.Where, center, 7, border, 1)
+	if checked {
+		render.circle(block.Where, center, 3, theme.CheckboxCheck, CVUI_FILLED)
+	}
+}
@@ -0,0 +1,273 @@
+package gocvui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	"gocv.io/x/gocv"
+)
+
+// HexToScalar converts a 0xRRGGBB hex color into the RGBA struct
+// expected by the gocv drawing calls.
+//
+// Deprecated: use RGB, which this delegates to.
+func (in *Internal) HexToScalar(hexColor uint32) color.RGBA {
+	return RGB(hexColor)
+}
+
+// Render implements all drawing performed by the library, on top of the
+// OpenCV drawing primitives.
+type Render struct{}
+
+var render = &Render{}
+
+// The drawing primitives multiply all geometry by the scale of the
+// current context (see SetScaleForWindow); widgets keep working in
+// logical coordinates.
+
+func (r *Render) rectangle(where *gocv.Mat, rect Rect, hexColor uint32, thickness int) {
+	s := internal.contextScale()
+	area := image.Rect(scaled(rect.X, s), scaled(rect.Y, s), scaled(rect.X+rect.Width, s), scaled(rect.Y+rect.Height, s))
+	if renderBackend != nil {
+		renderBackend.Rectangle(where, area, hexColor, scaledThickness(thickness, s))
+		return
+	}
+	gocv.Rectangle(where, area, RGB(hexColor), scaledThickness(thickness, s))
+}
+
+func (r *Render) line(where *gocv.Mat, from, to Point, hexColor uint32) {
+	r.thickLine(where, from, to, hexColor, 1)
+}
+
+func (r *Render) thickLine(where *gocv.Mat, from, to Point, hexColor uint32, thickness int) {
+	s := internal.contextScale()
+	a := image.Pt(scaled(from.X, s), scaled(from.Y, s))
+	b := image.Pt(scaled(to.X, s), scaled(to.Y, s))
+	if renderBackend != nil {
+		renderBackend.Line(where, a, b, hexColor, scaledThickness(thickness, s))
+		return
+	}
+	gocv.Line(where, a, b, RGB(hexColor), scaledThickness(thickness, s))
+}
+
+func (r *Render) circle(where *gocv.Mat, center Point, radius int, hexColor uint32, thickness int) {
+	s := internal.contextScale()
+	at := image.Pt(scaled(center.X, s), scaled(center.Y, s))
+	if renderBackend != nil {
+		renderBackend.Circle(where, at, scaled(radius, s), hexColor, scaledThickness(thickness, s))
+		return
+	}
+	gocv.Circle(where, at, scaled(radius, s), RGB(hexColor), scaledThickness(thickness, s))
+}
+
+func (r *Render) putText(where *gocv.Mat, text string, position Point, fontScale float64, hexColor uint32) {
+	s := internal.contextScale()
+	at := image.Pt(scaled(position.X, s), scaled(position.Y, s))
+	if renderBackend != nil {
+		renderBackend.PutText(where, text, at, fontScale*s, hexColor)
+		return
+	}
+	gocv.PutText(where, text, at, gocv.FontHersheySimplex, fontScale*s, RGB(hexColor), scaledThickness(1, s))
+}
+
+// GetTextSize measures text the same way the library renders it,
+// returning the size in logical coordinates.
+func (r *Render) GetTextSize(text string, fontScale float64) Size {
+	s := internal.contextScale()
+	size := gocv.GetTextSize(text, gocv.FontHersheySimplex, fontScale*s, scaledThickness(1, s))
+	return Size{int(float64(size.X)/s + 0.5), int(float64(size.Y)/s + 0.5)}
+}
+
+// scaled maps a logical coordinate to a rendered one.
+func scaled(v int, s float64) int {
+	if s == 1 {
+		return v
+	}
+	return int(float64(v)*s + 0.5)
+}
+
+// scaledThickness scales a positive stroke width, leaving CVUI_FILLED
+// untouched.
+func scaledThickness(thickness int, s float64) int {
+	if thickness < 0 || s == 1 {
+		return thickness
+	}
+	t := int(float64(thickness)*s + 0.5)
+	if t < 1 {
+		t = 1
+	}
+	return t
+}
+
+// Text renders a piece of text.
+func (r *Render) Text(block *Block, text string, position Point, fontScale float64, hexColor uint32) {
+	r.putText(block.Where, text, position, fontScale, hexColor)
+}
+
+// Counter renders the central value area of a counter.
+func (r *Render) Counter(block *Block, shape Rect, value string) {
+	r.rectangle(block.Where, shape, ColorInset, CVUI_FILLED)
+	r.rectangle(block.Where, shape, ColorBorder, 1)
+
+	size := r.GetTextSize(value, 0.4)
+	position := Point{shape.X + shape.Width/2 - size.Width/2, shape.Y + size.Height/2 + shape.Height/2}
+	r.putText(block.Where, value, position, 0.4, ColorText)
+}
+
+// Button renders a button in one of its three interaction states, with
+// the fill color animated between states unless the theme disables it.
+func (r *Render) Button(block *Block, state int, shape Rect) {
+	target := map[int]uint32{
+		OUT:  theme.ButtonFillOut,
+		OVER: theme.ButtonFillOver,
+		DOWN: theme.ButtonFillDown,
+	}[state]
+	fill := internal.AnimatedColor(internal.StateID("button.fill", shape.X, shape.Y), target, hoverAnimationDuration)
+
+	r.rectangle(block.Where, shape, fill, CVUI_FILLED)
+	r.rectangle(block.Where, shape, theme.ButtonBorder, 1)
+}
+
+// ButtonLabel renders the label of a button, underlining the '&'
+// keyboard shortcut character when present.
+func (r *Render) ButtonLabel(block *Block, state int, rect Rect, label string, textSize Size) {
+	parsed := internal.CreateLabel(label)
+	text := parsed.TextBeforeShortcut + string(parsed.Shortcut) + parsed.TextAfterShortcut
+	if !parsed.HasShortcut {
+		text = label
+	}
+
+	position := Point{rect.X + rect.Width/2 - textSize.Width/2, rect.Y + rect.Height/2 + textSize.Height/2}
+	if state == DOWN {
+		position.X++
+		position.Y++
+	}
+	r.putText(block.Where, text, position, 0.4, ColorText)
+
+	if parsed.HasShortcut {
+		before := r.GetTextSize(parsed.TextBeforeShortcut, 0.4)
+		width := r.GetTextSize(string(parsed.Shortcut), 0.4).Width
+		start := position.X + before.Width
+		r.line(block.Where, Point{start, position.Y + 3}, Point{start + width, position.Y + 3}, ColorText)
+	}
+}
+
+// Checkbox renders the box of a checkbox, animating the border color
+// between interaction states unless the theme disables it.
+func (r *Render) Checkbox(block *Block, state int, shape Rect) {
+	r.rectangle(block.Where, shape, theme.CheckboxFill, CVUI_FILLED)
+	target := theme.CheckboxBorderOut
+	if state != OUT {
+		target = theme.CheckboxBorderOver
+	}
+	border := internal.AnimatedColor(internal.StateID("checkbox.border", shape.X, shape.Y), target, hoverAnimationDuration)
+	r.rectangle(block.Where, shape, border, 1)
+}
+
+// CheckboxLabel renders the label next to a checkbox.
+func (r *Render) CheckboxLabel(block *Block, rect Rect, label string, textSize Size, hexColor uint32) {
+	position := Point{rect.X + rect.Width + 6, rect.Y + textSize.Height + rect.Height/2 - textSize.Height/2 - 1}
+	r.putText(block.Where, label, position, 0.4, hexColor)
+}
+
+// CheckboxCheck renders the check mark of a ticked checkbox.
+func (r *Render) CheckboxCheck(block *Block, shape Rect) {
+	inner := Rect{shape.X + 3, shape.Y + 3, shape.Width - 6, shape.Height - 6}
+	r.rectangle(block.Where, inner, ColorHighlight, CVUI_FILLED)
+}
+
+// Window renders the title bar and body of a window.
+func (r *Render) Window(block *Block, titleBar, content Rect, title string) {
+	r.rectangle(block.Where, titleBar, ColorTitleBar, CVUI_FILLED)
+	r.rectangle(block.Where, titleBar, ColorOutline, 1)
+	size := r.GetTextSize(title, 0.4)
+	r.putText(block.Where, title, Point{titleBar.X + 5, titleBar.Y + size.Height + (titleBar.Height-size.Height)/2 - 1}, 0.4, ColorText)
+
+	r.rectangle(block.Where, content, ColorPanel, CVUI_FILLED)
+	r.rectangle(block.Where, content, ColorOutline, 1)
+}
+
+// Rect renders a rectangle with a border and fill color. A fill color of
+// 0xffffffff means no filling at all.
+func (r *Render) Rect(block *Block, position Rect, borderColor, fillingColor uint32) {
+	if fillingColor != 0xffffffff {
+		r.rectangle(block.Where, position, fillingColor, CVUI_FILLED)
+	}
+	r.rectangle(block.Where, position, borderColor, 1)
+}
+
+// Sparkline renders values as a line chart scaled into rect.
+func (r *Render) Sparkline(block *Block, values []float64, rect Rect, min, max float64, hexColor uint32) {
+	size := len(values)
+	gap := float64(rect.Width) / float64(size-1)
+	scale := max - min
+	if scale < 1e-10 {
+		scale = 1e-10
+	}
+
+	for i := 0; i <= size-2; i++ {
+		x := float64(rect.X) + float64(i)*gap
+		y1 := float64(rect.Y+rect.Height) - (values[i]-min)/scale*float64(rect.Height-5) - 5
+		y2 := float64(rect.Y+rect.Height) - (values[i+1]-min)/scale*float64(rect.Height-5) - 5
+		r.line(block.Where, Point{int(x), int(y1)}, Point{int(x + gap), int(y2)}, hexColor)
+	}
+}
+
+// TrackbarHandle renders the draggable marker of a trackbar.
+func (r *Render) TrackbarHandle(block *Block, state int, shape Rect, value float64, params TrackbarParams, workingArea Rect) {
+	position := internal.TrackbarValueToXPixel(params, shape, value)
+	barTop := workingArea.Y + workingArea.Height/2
+
+	hexColor := ColorText
+	radius := 3
+	if state == OVER || state == DOWN {
+		hexColor = ColorHighlight
+		radius = 4
+	}
+	r.circle(block.Where, Point{position, barTop}, radius, hexColor, CVUI_FILLED)
+
+	if params.Options&TRACKBAR_HIDE_VALUE_LABEL == 0 {
+		text := fmt.Sprintf(params.LabelFormat, value)
+		size := r.GetTextSize(text, 0.3)
+		r.putText(block.Where, text, Point{position - size.Width/2, shape.Y + size.Height}, 0.3, ColorText)
+	}
+}
+
+// TrackbarPath renders the horizontal line of a trackbar.
+func (r *Render) TrackbarPath(block *Block, state int, shape Rect, workingArea Rect) {
+	barTop := workingArea.Y + workingArea.Height/2
+	r.line(block.Where, Point{workingArea.X, barTop}, Point{workingArea.X + workingArea.Width, barTop}, ColorDimText)
+}
+
+// TrackbarSteps renders the segment marks and min/max labels of a trackbar.
+func (r *Render) TrackbarSteps(block *Block, shape Rect, params TrackbarParams, workingArea Rect) {
+	barTop := workingArea.Y + workingArea.Height/2
+
+	segments := params.Segments
+	if segments < 1 {
+		segments = 1
+	}
+	for i := 0; i <= segments; i++ {
+		value := params.Min + (params.Max-params.Min)*float64(i)/float64(segments)
+		x := internal.TrackbarValueToXPixel(params, shape, value)
+		r.line(block.Where, Point{x, barTop - 3}, Point{x, barTop + 3}, ColorDimText)
+
+		if params.Options&TRACKBAR_HIDE_SEGMENT_LABELS == 0 {
+			text := fmt.Sprintf(params.LabelFormat, value)
+			size := r.GetTextSize(text, 0.3)
+			r.putText(block.Where, text, Point{x - size.Width/2, barTop + size.Height + 8}, 0.3, ColorDimText)
+		}
+	}
+}
+
+// Image renders image onto the block at position.
+func (r *Render) Image(block *Block, position Rect, image gocv.Mat) {
+	region := block.Where.Region(imageRectOf(position))
+	image.CopyTo(&region)
+	region.Close()
+}
+
+func imageRectOf(rect Rect) image.Rectangle {
+	return image.Rect(rect.X, rect.Y, rect.X+rect.Width, rect.Y+rect.Height)
+}
@@ -0,0 +1,35 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// RenderWidgetTo runs widget code against an arbitrary standalone Mat:
+// draw is called with the layout state isolated from the surrounding
+// frame and with mouse interaction disabled — coordinates of a
+// detached Mat do not line up with the cursor — so UI fragments like
+// legends or badges can be pre-rendered once and composited manually,
+// e.g. onto GPU-bound pipelines. Pass dst as the frame argument of the
+// widget calls inside draw:
+//
+//	badge := gocv.NewMatWithSize(30, 120, gocv.MatTypeCV8UC3)
+//	gocvui.RenderWidgetTo(&badge, func() {
+//		gocvui.Text(&badge, 5, 10, "REC")
+//	})
+func RenderWidgetTo(dst *gocv.Mat, draw func()) {
+	if draw == nil {
+		return
+	}
+
+	savedScreen := *internal.Screen
+	savedStack := internal.StackCount
+	wasDisabled := internal.disabled
+
+	internal.disabled = true
+	internal.ScreenBlock(dst)
+	draw()
+
+	internal.disabled = wasDisabled
+	internal.StackCount = savedStack
+	*internal.Screen = savedScreen
+}
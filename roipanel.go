@@ -0,0 +1,68 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	roiPanelGap     = 8
+	roiPanelPadding = 6
+)
+
+// PanelForROI attaches a parameter panel to a region of interest: the
+// panel is anchored next to roi — preferring the right side, then the
+// left, below and above, whichever fits inside the frame — with a
+// connector line tying the two together. Widgets rendered inside draw
+// flow top to bottom and hit-test at their on-screen position, so
+// per-region settings (e.g. different thresholds per zone) work like
+// any other widgets.
+func PanelForROI(frame *gocv.Mat, roi Rect, width, height int, draw func()) {
+	area := roiPanelAnchor(roi, width, height, frame.Cols(), frame.Rows())
+
+	block := internal.ScreenBlock(frame)
+	render.line(block.Where, roiCenter(roi), roiCenter(area), ColorBorder)
+	render.rectangle(block.Where, roi, theme.CheckboxCheck, 1)
+	render.rectangle(block.Where, area, ColorPanel, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorBorder, 1)
+
+	internal.BeginBlock(frame, area.X+roiPanelPadding, area.Y+roiPanelPadding, width-2*roiPanelPadding, height-2*roiPanelPadding, roiPanelPadding, COLUMN)
+	draw()
+	internal.EndBlock()
+}
+
+// roiPanelAnchor picks the panel position around roi, clamped to the
+// frame bounds.
+func roiPanelAnchor(roi Rect, width, height, frameWidth, frameHeight int) Rect {
+	candidates := []Rect{
+		{roi.X + roi.Width + roiPanelGap, roi.Y, width, height},
+		{roi.X - roiPanelGap - width, roi.Y, width, height},
+		{roi.X, roi.Y + roi.Height + roiPanelGap, width, height},
+		{roi.X, roi.Y - roiPanelGap - height, width, height},
+	}
+	for _, candidate := range candidates {
+		if candidate.X >= 0 && candidate.Y >= 0 && candidate.X+width <= frameWidth && candidate.Y+height <= frameHeight {
+			return candidate
+		}
+	}
+
+	area := candidates[0]
+	area.X = clampInt(area.X, 0, maxInt(frameWidth-width, 0))
+	area.Y = clampInt(area.Y, 0, maxInt(frameHeight-height, 0))
+	return area
+}
+
+// clampInt limits value to the min..max range.
+func clampInt(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// roiCenter is the midpoint of a rect.
+func roiCenter(rect Rect) Point {
+	return Point{rect.X + rect.Width/2, rect.Y + rect.Height/2}
+}
@@ -0,0 +1,192 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	roiHandleSize  = 6
+	roiHandleHit   = 5
+	roiMinSize     = 4
+	roiNudgeAmount = 1
+)
+
+const (
+	roiIdle = iota
+	roiCreating
+	roiMoving
+	roiResizing
+)
+
+// roiSelectState tracks the drag in progress on one selection widget.
+type roiSelectState struct {
+	Mode      int
+	Handle    int
+	Start     Point
+	StartRect Rect
+}
+
+// ROISelect lets the user drag out a region of interest over the
+// bounds area and writes it to *roi. An existing region shows corner
+// and edge handles for resizing, drags inside it move it, and the
+// arrow keys nudge it by one pixel while the cursor is over it.
+// Holding Shift while creating or resizing keeps the region's aspect
+// ratio; passing lockAspect overrides the key and forces the lock on
+// or off. Returns true when *roi changed this frame.
+func ROISelect(frame *gocv.Mat, bounds Rect, roi *Rect, lockAspect ...bool) bool {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+	lock := mouse.Modifiers&EVENT_FLAG_SHIFTKEY != 0
+	if len(lockAspect) > 0 {
+		lock = lockAspect[0]
+	}
+
+	state := internal.State(internal.StateID("roiselect", bounds.X, bounds.Y), func() interface{} {
+		return &roiSelectState{}
+	}).(*roiSelectState)
+
+	before := *roi
+
+	if state.Mode == roiIdle && mouse.AnyButton.JustPressed && bounds.Contains(mouse.Position) {
+		state.Start = mouse.Position
+		state.StartRect = *roi
+		if handle := roiHandleAt(*roi, mouse.Position); handle >= 0 {
+			state.Mode = roiResizing
+			state.Handle = handle
+		} else if roi.Contains(mouse.Position) {
+			state.Mode = roiMoving
+		} else {
+			state.Mode = roiCreating
+		}
+	}
+
+	if state.Mode != roiIdle {
+		if !mouse.AnyButton.Pressed {
+			state.Mode = roiIdle
+		} else {
+			switch state.Mode {
+			case roiCreating:
+				*roi = rectBetween(state.Start, clampPoint(mouse.Position, bounds))
+				if lock {
+					*roi = lockRectAspect(*roi, state.StartRect)
+				}
+			case roiMoving:
+				roi.X = state.StartRect.X + mouse.Position.X - state.Start.X
+				roi.Y = state.StartRect.Y + mouse.Position.Y - state.Start.Y
+				roi.X = clampInt(roi.X, bounds.X, bounds.X+bounds.Width-roi.Width)
+				roi.Y = clampInt(roi.Y, bounds.Y, bounds.Y+bounds.Height-roi.Height)
+			case roiResizing:
+				*roi = resizeRect(state.StartRect, state.Handle, mouse.Position.X-state.Start.X, mouse.Position.Y-state.Start.Y)
+				if lock {
+					*roi = lockRectAspect(*roi, state.StartRect)
+				}
+			}
+		}
+	}
+
+	if state.Mode == roiIdle && roi.Contains(mouse.Position) {
+		switch internal.LastKeyPressed {
+		case 81:
+			roi.X -= roiNudgeAmount
+		case 82:
+			roi.Y -= roiNudgeAmount
+		case 83:
+			roi.X += roiNudgeAmount
+		case 84:
+			roi.Y += roiNudgeAmount
+		}
+	}
+
+	if roi.Width >= roiMinSize && roi.Height >= roiMinSize {
+		render.rectangle(block.Where, *roi, theme.CheckboxCheck, 1)
+		if state.Mode != roiCreating {
+			for _, handle := range roiHandles(*roi) {
+				render.rectangle(block.Where, handle, theme.CheckboxCheck, CVUI_FILLED)
+			}
+		}
+	}
+
+	return *roi != before
+}
+
+// roiHandles returns the corner and edge handle rects of a region,
+// clockwise from the top-left corner.
+func roiHandles(roi Rect) []Rect {
+	xs := []int{roi.X, roi.X + roi.Width/2, roi.X + roi.Width}
+	ys := []int{roi.Y, roi.Y + roi.Height/2, roi.Y + roi.Height}
+	handles := make([]Rect, 0, 8)
+	for _, row := range []int{0, 1, 2} {
+		for _, col := range []int{0, 1, 2} {
+			if row == 1 && col == 1 {
+				continue
+			}
+			handles = append(handles, Rect{xs[col] - roiHandleSize/2, ys[row] - roiHandleSize/2, roiHandleSize, roiHandleSize})
+		}
+	}
+	return handles
+}
+
+// roiHandleAt returns the index of the handle under the cursor, -1 if
+// none.
+func roiHandleAt(roi Rect, cursor Point) int {
+	if roi.Width < roiMinSize || roi.Height < roiMinSize {
+		return -1
+	}
+	for i, handle := range roiHandles(roi) {
+		grown := Rect{handle.X - roiHandleHit, handle.Y - roiHandleHit, handle.Width + 2*roiHandleHit, handle.Height + 2*roiHandleHit}
+		if grown.Contains(cursor) {
+			return i
+		}
+	}
+	return -1
+}
+
+// resizeRect applies a handle drag delta to a rect. Handle indices
+// follow roiHandles: 0..2 top row, 3..4 middle sides, 5..7 bottom row.
+func resizeRect(rect Rect, handle, dx, dy int) Rect {
+	left := handle == 0 || handle == 3 || handle == 5
+	right := handle == 2 || handle == 4 || handle == 7
+	top := handle <= 2
+	bottom := handle >= 5
+
+	if left {
+		rect.X += dx
+		rect.Width -= dx
+	}
+	if right {
+		rect.Width += dx
+	}
+	if top {
+		rect.Y += dy
+		rect.Height -= dy
+	}
+	if bottom {
+		rect.Height += dy
+	}
+
+	if rect.Width < roiMinSize {
+		rect.Width = roiMinSize
+	}
+	if rect.Height < roiMinSize {
+		rect.Height = roiMinSize
+	}
+	return rect
+}
+
+// lockRectAspect constrains rect to the aspect ratio of reference,
+// falling back to a square when the reference is degenerate.
+func lockRectAspect(rect, reference Rect) Rect {
+	ratio := 1.0
+	if reference.Width > 0 && reference.Height > 0 {
+		ratio = float64(reference.Width) / float64(reference.Height)
+	}
+	rect.Height = int(float64(rect.Width) / ratio)
+	return rect
+}
+
+// clampPoint limits a point to the bounds area.
+func clampPoint(point Point, bounds Rect) Point {
+	point.X = clampInt(point.X, bounds.X, bounds.X+bounds.Width)
+	point.Y = clampInt(point.Y, bounds.Y, bounds.Y+bounds.Height)
+	return point
+}
@@ -0,0 +1,141 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// SelectionMode controls how a finished rubber-band drag combines with
+// the existing selection.
+type SelectionMode int
+
+const (
+	// SelectionReplace makes the dragged region the new selection.
+	SelectionReplace SelectionMode = iota
+	// SelectionAdd adds the dragged region to the selection, the
+	// usual Shift semantics.
+	SelectionAdd
+	// SelectionToggle inverts the selection state of the dragged
+	// region, the usual Ctrl semantics.
+	SelectionToggle
+)
+
+// RubberBand is a generic multi-select service: given the rects of
+// selectable items — gallery thumbnails, bounding boxes, list rows —
+// it tracks a band dragged over them and keeps the set of selected
+// indices. The application owns the instance so the selection survives
+// between frames.
+type RubberBand struct {
+	// Selected holds the indices of the currently selected items.
+	Selected map[int]bool
+
+	active bool
+	start  Point
+}
+
+// NewRubberBand returns a rubber-band service with nothing selected.
+func NewRubberBand() *RubberBand {
+	return &RubberBand{Selected: map[int]bool{}}
+}
+
+// Update runs the service for a frame: drags starting inside bounds
+// draw a selection rectangle, and on release every item rect that
+// intersects it enters the selection according to mode. A
+// SelectionReplace drag is promoted to SelectionAdd while Shift is
+// held and to SelectionToggle while Ctrl is held; explicit modes keep
+// their behavior. Returns true when the selection changed this frame.
+func (b *RubberBand) Update(frame *gocv.Mat, bounds Rect, items []Rect, mode SelectionMode) bool {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	if mode == SelectionReplace {
+		if mouse.Modifiers&EVENT_FLAG_SHIFTKEY != 0 {
+			mode = SelectionAdd
+		} else if mouse.Modifiers&EVENT_FLAG_CTRLKEY != 0 {
+			mode = SelectionToggle
+		}
+	}
+
+	if !b.active {
+		if mouse.AnyButton.JustPressed && bounds.Contains(mouse.Position) {
+			b.active = true
+			b.start = mouse.Position
+		}
+		return false
+	}
+
+	band := rectBetween(b.start, mouse.Position)
+	if !mouse.AnyButton.Pressed && !mouse.AnyButton.JustReleased {
+		// The press was consumed elsewhere (e.g. a modal); drop the drag.
+		b.active = false
+		return false
+	}
+
+	if mouse.AnyButton.JustReleased {
+		b.active = false
+		return b.apply(band, items, mode)
+	}
+
+	internal.QueueOverlay(func() {
+		render.rectangle(block.Where, band, theme.CheckboxCheck, 1)
+	})
+	return false
+}
+
+// apply folds the items intersecting band into the selection according
+// to mode, reporting whether the selection changed.
+func (b *RubberBand) apply(band Rect, items []Rect, mode SelectionMode) bool {
+	hit := map[int]bool{}
+	for index, item := range items {
+		if rectsIntersect(band, item) {
+			hit[index] = true
+		}
+	}
+
+	changed := false
+	switch mode {
+	case SelectionAdd:
+		for index := range hit {
+			if !b.Selected[index] {
+				b.Selected[index] = true
+				changed = true
+			}
+		}
+	case SelectionToggle:
+		for index := range hit {
+			if b.Selected[index] {
+				delete(b.Selected, index)
+			} else {
+				b.Selected[index] = true
+			}
+			changed = true
+		}
+	default:
+		changed = len(hit) != len(b.Selected)
+		for index := range hit {
+			if !b.Selected[index] {
+				changed = true
+			}
+		}
+		b.Selected = hit
+	}
+	return changed
+}
+
+// rectBetween returns the normalized rectangle spanned by two corners.
+func rectBetween(a, b Point) Rect {
+	rect := Rect{X: a.X, Y: a.Y, Width: b.X - a.X, Height: b.Y - a.Y}
+	if rect.Width < 0 {
+		rect.X += rect.Width
+		rect.Width = -rect.Width
+	}
+	if rect.Height < 0 {
+		rect.Y += rect.Height
+		rect.Height = -rect.Height
+	}
+	return rect
+}
+
+// rectsIntersect reports whether two rectangles overlap.
+func rectsIntersect(a, b Rect) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width && a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+}
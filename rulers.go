@@ -0,0 +1,84 @@
+package gocvui
+
+import (
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	rulerThickness    = 16
+	rulerMinTickSpace = 40
+	rulerTickLength   = 4
+)
+
+// rulerSteps are the candidate tick intervals in image pixels.
+var rulerSteps = []int{5, 10, 25, 50, 100, 250, 500, 1000}
+
+// ViewerState describes how an image sits in the UI frame: the
+// viewport rect it occupies and the Transform mapping its coordinates
+// — typically the one returned by Letterbox.
+type ViewerState struct {
+	Area      Rect
+	Transform Transform
+}
+
+// Rulers draws pixel-ruler bars along the top and left edges of an
+// image viewer, with tick labels in image coordinates and a readout of
+// the cursor position while it hovers the viewport — for precise
+// inspection work.
+func Rulers(frame *gocv.Mat, viewer *ViewerState) {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+	area := viewer.Area
+
+	top := Rect{area.X, area.Y - rulerThickness, area.Width, rulerThickness}
+	left := Rect{area.X - rulerThickness, area.Y, rulerThickness, area.Height}
+	render.rectangle(block.Where, top, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, left, ColorInset, CVUI_FILLED)
+	render.rectangle(block.Where, top, ColorBorder, 1)
+	render.rectangle(block.Where, left, ColorBorder, 1)
+
+	step := rulerStep(viewer.Transform.Scale)
+	origin := viewer.Transform.ToSource(Point{area.X, area.Y})
+	limit := viewer.Transform.ToSource(Point{area.X + area.Width, area.Y + area.Height})
+
+	for value := (origin.X / step) * step; value <= limit.X; value += step {
+		at := viewer.Transform.ToFrame(Point{value, 0})
+		if at.X < area.X || at.X > area.X+area.Width {
+			continue
+		}
+		render.line(block.Where, Point{at.X, top.Y + rulerThickness - rulerTickLength}, Point{at.X, top.Y + rulerThickness}, theme.TextColor)
+		render.putText(block.Where, fmt.Sprintf("%d", value), Point{at.X + 2, top.Y + 10}, 0.25, ColorDimText)
+	}
+	for value := (origin.Y / step) * step; value <= limit.Y; value += step {
+		at := viewer.Transform.ToFrame(Point{0, value})
+		if at.Y < area.Y || at.Y > area.Y+area.Height {
+			continue
+		}
+		render.line(block.Where, Point{left.X + rulerThickness - rulerTickLength, at.Y}, Point{left.X + rulerThickness, at.Y}, theme.TextColor)
+		render.putText(block.Where, fmt.Sprintf("%d", value), Point{left.X + 1, at.Y - 2}, 0.25, ColorDimText)
+	}
+
+	if area.Contains(mouse.Position) {
+		position := viewer.Transform.ToSource(mouse.Position)
+		render.line(block.Where, Point{mouse.Position.X, top.Y}, Point{mouse.Position.X, top.Y + rulerThickness}, ColorHighlight)
+		render.line(block.Where, Point{left.X, mouse.Position.Y}, Point{left.X + rulerThickness, mouse.Position.Y}, ColorHighlight)
+		readout := fmt.Sprintf("%d, %d", position.X, position.Y)
+		render.putText(block.Where, readout, Point{area.X + 4, area.Y + area.Height - 6}, 0.35, ColorHighlight)
+	}
+}
+
+// rulerStep picks the tick interval keeping ticks at least
+// rulerMinTickSpace apart on screen.
+func rulerStep(scale float64) int {
+	if scale <= 0 {
+		scale = 1
+	}
+	for _, step := range rulerSteps {
+		if float64(step)*scale >= rulerMinTickSpace {
+			return step
+		}
+	}
+	return rulerSteps[len(rulerSteps)-1]
+}
@@ -0,0 +1,33 @@
+package gocvui
+
+// SameLine rewinds the layout anchor of the current block so the next
+// component is placed immediately to the right of the previous one —
+// the way to put a label next to a checkbox inside a column without
+// opening a nested row:
+//
+//	gocvui.Text("Threshold")
+//	gocvui.SameLine()
+//	gocvui.Text("42")
+func SameLine() {
+	block := internal.TopBlock()
+	if block == internal.Screen {
+		internal.WarnOnce("sameline-no-block", "SameLine() outside a begin*/end* block has no effect")
+		return
+	}
+	last := internal.lastItemRect
+	block.Anchor = Point{last.X + last.Width + block.Padding, last.Y}
+}
+
+// Overlay rewinds the layout anchor to the origin of the previous
+// component so the next one draws on top of it, e.g. a badge over an
+// image thumbnail. The overlapping component advances the layout
+// again, so follow it with SameLine or a regular flow as needed.
+func Overlay() {
+	block := internal.TopBlock()
+	if block == internal.Screen {
+		internal.WarnOnce("overlay-no-block", "Overlay() outside a begin*/end* block has no effect")
+		return
+	}
+	last := internal.lastItemRect
+	block.Anchor = Point{last.X, last.Y}
+}
@@ -0,0 +1,21 @@
+package gocvui
+
+// SetScaleForWindow sets the widget scale factor of the context of
+// windowName, so a UI spanning a HiDPI laptop window and a 1080p
+// external monitor window can size its widgets differently per
+// context. All geometry and text of that window render multiplied by
+// factor while widgets keep their logical coordinates: mouse positions
+// are mapped back to the unscaled layout. Images drawn with Image are
+// not rescaled.
+func SetScaleForWindow(windowName string, factor float64) {
+	internal.GetContext(windowName).Scale = factor
+}
+
+// contextScale returns the scale of the current context, defaulting
+// to 1.
+func (in *Internal) contextScale() float64 {
+	if scale := in.GetContext("").Scale; scale > 0 {
+		return scale
+	}
+	return 1
+}
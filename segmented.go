@@ -0,0 +1,56 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const segmentedHeight = 22
+
+// Segmented renders a row of mutually exclusive toggle buttons styled
+// as one connected control — e.g. "Gray | HSV | Edges" — bound to the
+// selected index at *selected. Segments share the width equally.
+// Returns true when the selection changes.
+func Segmented(frame *gocv.Mat, x, y, width int, segments []string, selected *int) bool {
+	block := internal.ScreenBlock(frame)
+	if len(segments) == 0 {
+		return false
+	}
+
+	changed := false
+	segmentWidth := width / len(segments)
+	for i, label := range segments {
+		shape := Rect{x + i*segmentWidth, y, segmentWidth, segmentedHeight}
+		if i == len(segments)-1 {
+			shape.Width = width - i*segmentWidth
+		}
+		status := internal.IArea(shape.X, shape.Y, shape.Width, shape.Height)
+		if status == CLICK && *selected != i {
+			*selected = i
+			changed = true
+		}
+
+		fill := ColorPanel
+		if i == *selected {
+			fill = theme.ButtonFillDown
+		} else if status == OVER || status == DOWN {
+			fill = theme.ButtonFillOver
+		}
+		render.rectangle(block.Where, shape, fill, CVUI_FILLED)
+
+		size := render.GetTextSize(label, 0.4)
+		textColor := ColorDimText
+		if i == *selected {
+			textColor = theme.TextColor
+		}
+		at := Point{shape.X + (shape.Width-size.Width)/2, shape.Y + size.Height + (shape.Height-size.Height)/2 - 1}
+		render.putText(block.Where, label, at, 0.4, textColor)
+
+		if i > 0 {
+			render.line(block.Where, Point{shape.X, shape.Y + 2}, Point{shape.X, shape.Y + shape.Height - 2}, ColorBorder)
+		}
+	}
+	render.rectangle(block.Where, Rect{x, y, width, segmentedHeight}, ColorBorder, 1)
+
+	internal.Describe("segmented", segments[clampInt(*selected, 0, len(segments)-1)], "", OUT, Rect{x, y, width, segmentedHeight})
+	return changed
+}
@@ -0,0 +1,98 @@
+package gocvui
+
+import (
+	"strings"
+)
+
+// RegisterShortcut binds a key combo to an action, run from inside the
+// Update cycle whenever the internal waitKey loop reports the combo.
+// Combos are written as "s", "ctrl+s", "shift+a", "esc", "enter",
+// "space" or an arrow key name, resolved to the codes waitKey actually
+// delivers: Ctrl+letter arrives as a control code and Shift+letter as
+// the uppercase letter, so Ctrl+Shift combos cannot be told apart from
+// plain Ctrl ones. Unknown combos are reported through the logger and
+// ignored:
+//
+//	gocvui.RegisterShortcut("ctrl+s", saveSettings)
+func RegisterShortcut(keyCombo string, action func()) {
+	code, ok := parseKeyCombo(keyCombo)
+	if !ok {
+		internal.WarnOnce("shortcut-"+keyCombo, "cannot parse shortcut", "combo", keyCombo)
+		return
+	}
+	if internal.shortcuts == nil {
+		internal.shortcuts = map[int]func(){}
+	}
+	internal.shortcuts[code] = action
+}
+
+// UnregisterShortcut removes the action bound to a key combo, if any.
+func UnregisterShortcut(keyCombo string) {
+	if code, ok := parseKeyCombo(keyCombo); ok {
+		delete(internal.shortcuts, code)
+	}
+}
+
+// keyComboNames maps the spelled-out key names to their waitKey codes.
+var keyComboNames = map[string]int{
+	"esc":       27,
+	"enter":     13,
+	"space":     32,
+	"tab":       9,
+	"backspace": 8,
+	"left":      81,
+	"up":        82,
+	"right":     83,
+	"down":      84,
+}
+
+// parseKeyCombo resolves a combo like "ctrl+s" into the key code the
+// waitKey loop reports for it.
+func parseKeyCombo(combo string) (int, bool) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(combo)), "+")
+
+	ctrl, shift := false, false
+	key := ""
+	for _, part := range parts {
+		switch part {
+		case "ctrl":
+			ctrl = true
+		case "shift":
+			shift = true
+		default:
+			if key != "" {
+				return 0, false
+			}
+			key = part
+		}
+	}
+
+	if code, ok := keyComboNames[key]; ok {
+		return code, !ctrl && !shift
+	}
+	if len(key) != 1 {
+		return 0, false
+	}
+	c := key[0]
+
+	switch {
+	case ctrl && c >= 'a' && c <= 'z':
+		return int(c-'a') + 1, true
+	case shift && c >= 'a' && c <= 'z':
+		return int(c - 'a' + 'A'), true
+	case !ctrl && !shift:
+		return int(c), true
+	}
+	return 0, false
+}
+
+// dispatchShortcuts runs the action bound to the key reported by the
+// latest waitKey pump, if any.
+func (in *Internal) dispatchShortcuts(code int) {
+	if code < 0 {
+		return
+	}
+	if action, ok := in.shortcuts[code]; ok {
+		action()
+	}
+}
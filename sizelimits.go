@@ -0,0 +1,54 @@
+package gocvui
+
+// MinSize constrains the next component inside the current
+// begin*/end* block to occupy at least width x height in the layout
+// flow, reserving extra room around components that are naturally
+// smaller. A zero dimension leaves that axis unconstrained:
+//
+//	gocvui.BeginColumn(&frame, 10, 10)
+//	gocvui.MinSize(120, 0)
+//	gocvui.Button("OK")      // flows as if it were 120px wide
+//	gocvui.EndColumn()
+func MinSize(width, height int) {
+	internal.nextMinSize = Size{width, height}
+}
+
+// MaxSize constrains the next component inside the current
+// begin*/end* block to occupy at most width x height in the layout
+// flow. The component still renders at its natural size — the
+// constraint only limits the space the row or column sets aside for
+// it, letting oversized children overlap their neighbors instead of
+// pushing them away. A zero dimension leaves that axis unconstrained.
+func MaxSize(width, height int) {
+	internal.nextMaxSize = Size{width, height}
+}
+
+// FixedSize makes the next component occupy exactly width x height in
+// the layout flow, regardless of its natural size. It is shorthand for
+// MinSize and MaxSize with the same dimensions.
+func FixedSize(width, height int) {
+	internal.nextMinSize = Size{width, height}
+	internal.nextMaxSize = Size{width, height}
+}
+
+// clampChildSize applies and consumes the pending size constraints on
+// the size a component reports to the layout flow.
+func (in *Internal) clampChildSize(childSize Size) Size {
+	min, max := in.nextMinSize, in.nextMaxSize
+	in.nextMinSize = Size{}
+	in.nextMaxSize = Size{}
+
+	if min.Width > 0 && childSize.Width < min.Width {
+		childSize.Width = min.Width
+	}
+	if min.Height > 0 && childSize.Height < min.Height {
+		childSize.Height = min.Height
+	}
+	if max.Width > 0 && childSize.Width > max.Width {
+		childSize.Width = max.Width
+	}
+	if max.Height > 0 && childSize.Height > max.Height {
+		childSize.Height = max.Height
+	}
+	return childSize
+}
@@ -0,0 +1,53 @@
+package gocvui
+
+import (
+	"math"
+	"time"
+)
+
+// dragSmoothingTau is the time constant of the drag low-pass filter:
+// roughly how long a smoothed value takes to cover two thirds of the
+// distance to the pointer.
+const dragSmoothingTau = 60 * time.Millisecond
+
+// dragSmoother carries the filtered value of one drag between frames.
+type dragSmoother struct {
+	Value float64
+	Frame int
+}
+
+// SmoothDrag low-pass filters a dragged value toward target so noisy
+// mice or touchscreens don't feed jittery parameters into the vision
+// pipeline. The filter is keyed by id and resets to current whenever a
+// new drag starts, i.e. when the previous call was more than one frame
+// ago. The step is derived from the frame clock, keeping the response
+// independent of FPS.
+func (in *Internal) SmoothDrag(id string, target, current float64) float64 {
+	smoother := in.State(id, func() interface{} {
+		return &dragSmoother{Value: current}
+	}).(*dragSmoother)
+
+	if smoother.Frame != in.Clock.Frame()-1 {
+		smoother.Value = current
+	}
+	smoother.Frame = in.Clock.Frame()
+
+	alpha := 1 - math.Exp(-float64(in.Clock.Delta())/float64(dragSmoothingTau))
+	if alpha <= 0 || alpha > 1 {
+		alpha = 1
+	}
+	smoother.Value += (target - smoother.Value) * alpha
+	return smoother.Value
+}
+
+// snapWithHysteresis snaps value to multiples of step, but sticks to
+// the current snapped value until the drag has moved clearly past the
+// step boundary, so a value resting near one doesn't flicker between
+// neighboring steps.
+func snapWithHysteresis(value, current, step float64) float64 {
+	snapped := math.Round(value/step) * step
+	if snapped == current || math.Abs(value-current) < 0.6*step {
+		return current
+	}
+	return snapped
+}
@@ -0,0 +1,124 @@
+package gocvui
+
+import (
+	"fmt"
+	"image"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	snapshotButtonWidth  = 80
+	snapshotButtonHeight = 25
+	snapshotToastWidth   = 96
+	snapshotToastTime    = 2 * time.Second
+)
+
+// SnapshotOptions configures SnapshotControl. The zero value saves
+// "snapshot-{time}-{n}.png" into the working directory with a
+// three-shot burst.
+type SnapshotOptions struct {
+	// Dir is the directory snapshots are written to.
+	Dir string
+	// Template is the filename template; {time} expands to a
+	// timestamp and {n} to a per-session counter.
+	Template string
+	// BurstCount is the number of frames a burst captures; zero
+	// means 3.
+	BurstCount int
+	// BurstInterval is the delay between burst shots; zero means
+	// 200ms.
+	BurstInterval time.Duration
+}
+
+// snapshotState tracks the counter, the burst in progress and the
+// confirmation toast.
+type snapshotState struct {
+	Counter    int
+	burstLeft  int
+	burstDue   time.Duration
+	toastUntil time.Duration
+	toastName  string
+	thumb      gocv.Mat
+	hasThumb   bool
+}
+
+// SnapshotControl renders snapshot and burst buttons that save the
+// given clean (pre-UI) frame to disk using the filename template, with
+// a thumbnail toast confirming each write. Pass the frame before any
+// widgets are drawn on it so the files stay free of UI.
+func SnapshotControl(frame *gocv.Mat, x, y int, clean gocv.Mat, opts SnapshotOptions) {
+	block := internal.ScreenBlock(frame)
+
+	state := internal.State(internal.StateID("snapshot", x, y), func() interface{} {
+		return &snapshotState{}
+	}).(*snapshotState)
+
+	if internal.Button(block, x, y, snapshotButtonWidth, snapshotButtonHeight, "Snapshot", false) {
+		saveSnapshot(state, clean, opts)
+	}
+	if internal.Button(block, x+snapshotButtonWidth+4, y, snapshotButtonWidth, snapshotButtonHeight, "Burst", false) && state.burstLeft == 0 {
+		state.burstLeft = opts.BurstCount
+		if state.burstLeft <= 0 {
+			state.burstLeft = 3
+		}
+		state.burstDue = internal.Clock.Now()
+	}
+
+	if state.burstLeft > 0 && internal.Clock.Now() >= state.burstDue {
+		saveSnapshot(state, clean, opts)
+		state.burstLeft--
+		interval := opts.BurstInterval
+		if interval <= 0 {
+			interval = 200 * time.Millisecond
+		}
+		state.burstDue = internal.Clock.Now() + interval
+	}
+
+	if state.hasThumb && internal.Clock.Now() < state.toastUntil {
+		renderSnapshotToast(block, x, y+snapshotButtonHeight+6, state)
+	}
+}
+
+// saveSnapshot writes the clean frame through the naming template and
+// arms the confirmation toast.
+func saveSnapshot(state *snapshotState, clean gocv.Mat, opts SnapshotOptions) {
+	template := opts.Template
+	if template == "" {
+		template = "snapshot-{time}-{n}.png"
+	}
+	state.Counter++
+	name := strings.ReplaceAll(template, "{time}", time.Now().Format("20060102-150405"))
+	name = strings.ReplaceAll(name, "{n}", fmt.Sprintf("%03d", state.Counter))
+	path := filepath.Join(opts.Dir, name)
+
+	if !gocv.IMWrite(path, clean) {
+		// gocv.IMWrite reports failure as a bare bool; there is no
+		// underlying error to attach.
+		internal.WarnOnce("snapshot-write-"+path, "could not write snapshot", "path", path)
+		return
+	}
+
+	if state.hasThumb {
+		state.thumb.Close()
+	}
+	state.thumb = gocv.NewMat()
+	height := snapshotToastWidth * clean.Rows() / maxInt(clean.Cols(), 1)
+	gocv.Resize(clean, &state.thumb, image.Pt(snapshotToastWidth, maxInt(height, 1)), 0, 0, gocv.InterpolationArea)
+	state.hasThumb = true
+	state.toastName = name
+	state.toastUntil = internal.Clock.Now() + snapshotToastTime
+}
+
+// renderSnapshotToast draws the thumbnail confirmation below the
+// buttons.
+func renderSnapshotToast(block *Block, x, y int, state *snapshotState) {
+	area := Rect{x, y, state.thumb.Cols() + 8, state.thumb.Rows() + 22}
+	render.rectangle(block.Where, area, ColorPanel, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorBorder, 1)
+	render.Image(block, Rect{x + 4, y + 4, state.thumb.Cols(), state.thumb.Rows()}, state.thumb)
+	render.putText(block.Where, state.toastName, Point{x + 4, y + area.Height - 6}, 0.3, theme.TextColor)
+}
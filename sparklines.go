@@ -0,0 +1,59 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// Sparklines renders several value slices as one compact chart, one
+// colored line per series, reusing the PlotSeries type. With
+// sharedScale set all series render against one min/max scale, which
+// keeps their magnitudes comparable; otherwise each series is scaled
+// to the full height independently. Series with a non-empty label get
+// an entry in a small legend at the top-left corner.
+func Sparklines(frame *gocv.Mat, series []PlotSeries, x, y, width, height int, sharedScale bool) {
+	block := internal.ScreenBlock(frame)
+	rect := Rect{x, y, width, height}
+
+	min, max := 0.0, 0.0
+	first := true
+	for _, s := range series {
+		if len(s.Values) < 2 {
+			continue
+		}
+		seriesMin, seriesMax := internal.FindMinMax(s.Values)
+		if first || seriesMin < min {
+			min = seriesMin
+		}
+		if first || seriesMax > max {
+			max = seriesMax
+		}
+		first = false
+	}
+	if first {
+		internal.Text(block, x, y, "No data.", 0.4, ColorText, false)
+		return
+	}
+
+	for _, s := range series {
+		if len(s.Values) < 2 {
+			continue
+		}
+		if sharedScale {
+			render.Sparkline(block, s.Values, rect, min, max, s.Color)
+		} else {
+			seriesMin, seriesMax := internal.FindMinMax(s.Values)
+			render.Sparkline(block, s.Values, rect, seriesMin, seriesMax, s.Color)
+		}
+	}
+
+	legendY := y + 4
+	for _, s := range series {
+		if s.Label == "" {
+			continue
+		}
+		render.rectangle(block.Where, Rect{x + 4, legendY, plotLegendSwatch, plotLegendSwatch}, s.Color, CVUI_FILLED)
+		size := render.GetTextSize(s.Label, 0.3)
+		render.putText(block.Where, s.Label, Point{x + 4 + plotLegendSwatch + 4, legendY + size.Height + 1}, 0.3, theme.TextColor)
+		legendY += plotLegendRowHeight - 4
+	}
+}
@@ -0,0 +1,40 @@
+package gocvui
+
+import (
+	"math"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	spinnerDots   = 8
+	spinnerPeriod = 800 * time.Millisecond
+)
+
+// Spinner renders a small busy indicator for operations whose duration
+// is not known. It animates continuously while rendered each frame,
+// with the rotation derived from the internal clock so the speed is
+// independent of the frame rate.
+func Spinner(frame *gocv.Mat, x, y int, radius ...int) {
+	size := 8
+	if len(radius) > 0 {
+		size = radius[0]
+	}
+
+	phase := float64(internal.Clock.Now()%spinnerPeriod) / float64(spinnerPeriod)
+	head := int(phase * spinnerDots)
+
+	for i := 0; i < spinnerDots; i++ {
+		angle := 2 * math.Pi * float64(i) / spinnerDots
+		dotX := x + int(float64(size)*math.Cos(angle))
+		dotY := y + int(float64(size)*math.Sin(angle))
+
+		// Dots fade with their distance behind the head of the spinner.
+		distance := (head - i + spinnerDots) % spinnerDots
+		intensity := 1.0 - float64(distance)/spinnerDots
+		hexColor := lerpColor(0x383838, theme.TextColor, intensity)
+
+		render.circle(frame, Point{dotX, dotY}, 2, hexColor, CVUI_FILLED)
+	}
+}
@@ -0,0 +1,83 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	splitPaneHandle   = 5
+	splitPanePadding  = 4
+	splitPaneMinRatio = 0.1
+)
+
+// splitPaneState persists the divider position of one pane.
+type splitPaneState struct {
+	Ratio    float64
+	dragging bool
+}
+
+// SplitPane splits area into two regions separated by a draggable
+// divider — side by side when vertical is true (a vertical divider),
+// stacked otherwise. Each region is a begin/end block: the callbacks
+// run with a column layout confined to their half, and the split ratio
+// persists across frames.
+func SplitPane(frame *gocv.Mat, area Rect, vertical bool, first, second func()) {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	state := internal.State(internal.StateID("splitpane", area.X, area.Y), func() interface{} {
+		return &splitPaneState{Ratio: 0.5}
+	}).(*splitPaneState)
+
+	var handle Rect
+	if vertical {
+		handleX := area.X + int(float64(area.Width)*state.Ratio) - splitPaneHandle/2
+		handle = Rect{handleX, area.Y, splitPaneHandle, area.Height}
+	} else {
+		handleY := area.Y + int(float64(area.Height)*state.Ratio) - splitPaneHandle/2
+		handle = Rect{area.X, handleY, area.Width, splitPaneHandle}
+	}
+
+	status := internal.IArea(handle.X, handle.Y, handle.Width, handle.Height)
+	if status == DOWN {
+		state.dragging = true
+	}
+	if state.dragging {
+		if !mouse.AnyButton.Pressed {
+			state.dragging = false
+		} else if vertical {
+			state.Ratio = float64(mouse.Position.X-area.X) / float64(area.Width)
+		} else {
+			state.Ratio = float64(mouse.Position.Y-area.Y) / float64(area.Height)
+		}
+		state.Ratio = clampFloat(state.Ratio, splitPaneMinRatio, 1-splitPaneMinRatio)
+	}
+
+	var firstArea, secondArea Rect
+	if vertical {
+		firstWidth := int(float64(area.Width)*state.Ratio) - splitPaneHandle/2
+		firstArea = Rect{area.X, area.Y, firstWidth, area.Height}
+		secondArea = Rect{area.X + firstWidth + splitPaneHandle, area.Y, area.Width - firstWidth - splitPaneHandle, area.Height}
+	} else {
+		firstHeight := int(float64(area.Height)*state.Ratio) - splitPaneHandle/2
+		firstArea = Rect{area.X, area.Y, area.Width, firstHeight}
+		secondArea = Rect{area.X, area.Y + firstHeight + splitPaneHandle, area.Width, area.Height - firstHeight - splitPaneHandle}
+	}
+
+	handleColor := ColorBorder
+	if status != OUT || state.dragging {
+		handleColor = ColorHighlight
+	}
+	render.rectangle(block.Where, handle, handleColor, CVUI_FILLED)
+
+	if first != nil {
+		internal.BeginBlock(frame, firstArea.X+splitPanePadding, firstArea.Y+splitPanePadding, firstArea.Width-2*splitPanePadding, firstArea.Height-2*splitPanePadding, splitPanePadding, COLUMN)
+		first()
+		internal.EndBlock()
+	}
+	if second != nil {
+		internal.BeginBlock(frame, secondArea.X+splitPanePadding, secondArea.Y+splitPanePadding, secondArea.Width-2*splitPanePadding, secondArea.Height-2*splitPanePadding, splitPanePadding, COLUMN)
+		second()
+		internal.EndBlock()
+	}
+}
@@ -0,0 +1,65 @@
+package gocvui
+
+import (
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	statsOverlayWidth   = 140
+	statsOverlayHeight  = 48
+	statsOverlaySamples = 120
+)
+
+// statsOverlayState is the rolling frame-time buffer of one overlay.
+type statsOverlayState struct {
+	Samples   []float64
+	lastFrame int
+}
+
+// StatsOverlay renders the current FPS, the frame time in
+// milliseconds and a rolling frame-time sparkline, measuring the time
+// between Update calls internally — no buffers to keep on the
+// application side. Call it anywhere in the frame.
+func StatsOverlay(frame *gocv.Mat, x, y int) {
+	block := internal.ScreenBlock(frame)
+
+	state := internal.State(internal.StateID("statsoverlay", x, y), func() interface{} {
+		return &statsOverlayState{lastFrame: -1}
+	}).(*statsOverlayState)
+
+	if frameIndex := internal.Clock.Frame(); frameIndex != state.lastFrame {
+		state.lastFrame = frameIndex
+		millis := float64(internal.Clock.Delta().Microseconds()) / 1000
+		state.Samples = append(state.Samples, millis)
+		if len(state.Samples) > statsOverlaySamples {
+			state.Samples = state.Samples[1:]
+		}
+	}
+
+	area := Rect{x, y, statsOverlayWidth, statsOverlayHeight}
+	render.rectangle(block.Where, area, ColorPanel, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorBorder, 1)
+
+	average := 0.0
+	for _, sample := range state.Samples {
+		average += sample
+	}
+	if len(state.Samples) > 0 {
+		average /= float64(len(state.Samples))
+	}
+
+	fps := 0.0
+	if average > 0 {
+		fps = 1000 / average
+	}
+	render.putText(block.Where, fmt.Sprintf("%.0f FPS", fps), Point{x + 5, y + 13}, 0.4, theme.TextColor)
+	render.putText(block.Where, fmt.Sprintf("%.1f ms", average), Point{x + 70, y + 13}, 0.4, ColorDimText)
+
+	if len(state.Samples) >= 2 {
+		min, max := internal.FindMinMax(state.Samples)
+		chart := Rect{x + 4, y + 18, statsOverlayWidth - 8, statsOverlayHeight - 22}
+		render.Sparkline(block, state.Samples, chart, min, max, ColorHighlight)
+	}
+}
@@ -0,0 +1,60 @@
+package gocvui
+
+import (
+	"fmt"
+)
+
+const (
+	stepOverlayWidth  = 230
+	stepOverlayHeight = 46
+)
+
+// SetStepMode toggles frame-by-frame debugging: while enabled, Update
+// overlays the frame index, the input state and the last widget
+// interaction on the frame and then blocks until a key is pressed, so
+// interaction bugs that only occur in specific frame sequences can be
+// stepped through. Requires a positive delayWaitKey in Init, like
+// LastKeyPressed.
+func SetStepMode(enabled bool) {
+	internal.stepMode = enabled
+	if !enabled {
+		internal.lastInteraction = ""
+	}
+}
+
+// renderStepOverlay draws the step-debug box onto the frame last used
+// by the context, before the per-frame input state is reset.
+func (in *Internal) renderStepOverlay(context *Context) {
+	where := in.Screen.Where
+	if where == nil || where.Empty() {
+		return
+	}
+
+	box := Rect{2, 2, stepOverlayWidth, stepOverlayHeight}
+	render.rectangle(where, box, ColorPanel, CVUI_FILLED)
+	render.rectangle(where, box, ColorHighlight, 1)
+
+	mouse := context.Mouse
+	buttons := ""
+	if mouse.AnyButton.Pressed {
+		buttons += " down"
+	}
+	if mouse.AnyButton.JustPressed {
+		buttons += " just-pressed"
+	}
+	if mouse.AnyButton.JustReleased {
+		buttons += " just-released"
+	}
+	if buttons == "" {
+		buttons = " idle"
+	}
+
+	lines := []string{
+		fmt.Sprintf("frame %d  key %d", in.Clock.Frame(), in.LastKeyPressed),
+		fmt.Sprintf("mouse %d,%d%s", mouse.Position.X, mouse.Position.Y, buttons),
+		"last: " + in.lastInteraction,
+	}
+	for i, line := range lines {
+		render.putText(where, line, Point{box.X + 5, box.Y + 12 + i*13}, 0.3, theme.TextColor)
+	}
+}
@@ -0,0 +1,128 @@
+package gocvui
+
+import (
+	"fmt"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	stopwatchButtonWidth  = 52
+	stopwatchButtonHeight = 22
+	stopwatchRowHeight    = 14
+	stopwatchMaxLaps      = 8
+)
+
+// StopwatchState is the application-owned state of a Stopwatch.
+type StopwatchState struct {
+	// Running is true while the stopwatch counts.
+	Running bool
+	// Elapsed is the accumulated time.
+	Elapsed time.Duration
+	// Laps are the recorded lap times, oldest first.
+	Laps []time.Duration
+}
+
+// Stopwatch renders a stopwatch with start/stop, lap and reset buttons
+// and the lap list, advancing by the frame clock — handy for timing
+// experiments and capture sessions.
+func Stopwatch(frame *gocv.Mat, x, y int, state *StopwatchState) {
+	block := internal.ScreenBlock(frame)
+
+	if state.Running {
+		state.Elapsed += internal.Clock.Delta()
+	}
+
+	internal.Text(block, x, y+8, formatDuration(state.Elapsed), 0.6, ColorText, false)
+
+	buttonY := y + 18
+	label := "Start"
+	if state.Running {
+		label = "Stop"
+	}
+	if internal.Button(block, x, buttonY, stopwatchButtonWidth, stopwatchButtonHeight, label, false) {
+		state.Running = !state.Running
+	}
+	if internal.Button(block, x+stopwatchButtonWidth+4, buttonY, stopwatchButtonWidth, stopwatchButtonHeight, "Lap", false) && state.Running {
+		state.Laps = append(state.Laps, state.Elapsed)
+	}
+	if internal.Button(block, x+2*(stopwatchButtonWidth+4), buttonY, stopwatchButtonWidth, stopwatchButtonHeight, "Reset", false) {
+		state.Running = false
+		state.Elapsed = 0
+		state.Laps = nil
+	}
+
+	rowY := buttonY + stopwatchButtonHeight + 12
+	first := maxInt(len(state.Laps)-stopwatchMaxLaps, 0)
+	for i := first; i < len(state.Laps); i++ {
+		split := state.Laps[i]
+		if i > 0 {
+			split -= state.Laps[i-1]
+		}
+		line := fmt.Sprintf("#%d %s (+%s)", i+1, formatDuration(state.Laps[i]), formatDuration(split))
+		internal.Text(block, x, rowY, line, 0.35, ColorDimText, false)
+		rowY += stopwatchRowHeight
+	}
+}
+
+// CountdownState is the application-owned state of a Countdown.
+type CountdownState struct {
+	// Duration is the interval counted down from.
+	Duration time.Duration
+	// Remaining is the time left; starts at Duration.
+	Remaining time.Duration
+	// Running is true while the countdown ticks.
+	Running bool
+}
+
+// Countdown renders the interval timer variant of Stopwatch: it counts
+// Remaining down from Duration and invokes onDone once when it reaches
+// zero — e.g. to trigger a capture. Reset rearms it.
+func Countdown(frame *gocv.Mat, x, y int, state *CountdownState, onDone func()) {
+	block := internal.ScreenBlock(frame)
+
+	if state.Running {
+		state.Remaining -= internal.Clock.Delta()
+		if state.Remaining <= 0 {
+			state.Remaining = 0
+			state.Running = false
+			if onDone != nil {
+				onDone()
+			}
+		}
+	}
+
+	hexColor := ColorText
+	if state.Running && state.Remaining < time.Second {
+		hexColor = ColorHighlight
+	}
+	internal.Text(block, x, y+8, formatDuration(state.Remaining), 0.6, hexColor, false)
+
+	buttonY := y + 18
+	label := "Start"
+	if state.Running {
+		label = "Pause"
+	}
+	if internal.Button(block, x, buttonY, stopwatchButtonWidth, stopwatchButtonHeight, label, false) && state.Duration > 0 {
+		if !state.Running && state.Remaining == 0 {
+			state.Remaining = state.Duration
+		}
+		state.Running = !state.Running
+	}
+	if internal.Button(block, x+stopwatchButtonWidth+4, buttonY, stopwatchButtonWidth, stopwatchButtonHeight, "Reset", false) {
+		state.Running = false
+		state.Remaining = state.Duration
+	}
+}
+
+// formatDuration renders a duration as mm:ss.cc.
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	minutes := int(d.Minutes())
+	seconds := int(d.Seconds()) % 60
+	centis := int(d.Milliseconds()/10) % 100
+	return fmt.Sprintf("%02d:%02d.%02d", minutes, seconds, centis)
+}
@@ -0,0 +1,122 @@
+package gocvui
+
+// Stretch consumes free space of the enclosing begin*/end* block:
+//
+//	gocvui.BeginRow(&frame, 10, 10, 300, 30)
+//	gocvui.Button("Left")
+//	gocvui.Stretch(1)
+//	gocvui.Button("Right")   // pushed to the right edge
+//	gocvui.EndRow()
+//
+// Several stretches split the free space proportionally to their
+// weights, giving space-between layouts. The free space is measured
+// against the content of the previous frame — the only complete
+// measurement an immediate-mode pass has — so a layout change settles
+// within one frame.
+func Stretch(weight float64) {
+	internal.Stretch(weight)
+}
+
+// stretchBlockState carries the per-block stretch measurements across
+// frames.
+type stretchBlockState struct {
+	frame   int
+	weights []float64
+	alloc   []int
+
+	lastWeights []float64
+	lastAlloc   []int
+	lastFinal   int
+}
+
+// stretchScope links an open block to its stretch state so EndBlock
+// can record the final content size.
+type stretchScope struct {
+	depth int
+	state *stretchBlockState
+}
+
+// Stretch advances the layout anchor of the top block by this
+// stretch's share of the block's free space, measured from the
+// previous frame.
+func (in *Internal) Stretch(weight float64) {
+	block := in.TopBlock()
+	if block == in.Screen {
+		in.WarnOnce("stretch-no-block", "Stretch() outside a begin*/end* block has no effect")
+		return
+	}
+	if weight <= 0 {
+		weight = 1
+	}
+
+	state := in.State(in.StateID("stretch", block.Rect.X, block.Rect.Y), func() interface{} {
+		return &stretchBlockState{frame: -1}
+	}).(*stretchBlockState)
+
+	if state.frame != in.Clock.Frame() {
+		state.frame = in.Clock.Frame()
+		state.lastWeights = state.weights
+		state.lastAlloc = state.alloc
+		state.weights = nil
+		state.alloc = nil
+		in.stretchScopes = append(in.stretchScopes, stretchScope{in.StackCount, state})
+	}
+
+	size := in.stretchSize(block, state, len(state.weights), weight)
+	state.weights = append(state.weights, weight)
+	state.alloc = append(state.alloc, size)
+
+	if size <= 0 {
+		return
+	}
+	if block.Type == ROW {
+		in.UpdateLayoutFlow(block, Size{size - block.Padding, 0})
+	} else {
+		in.UpdateLayoutFlow(block, Size{0, size - block.Padding})
+	}
+}
+
+// stretchSize computes the share of the block's free space of the
+// index-th stretch, using the previous frame's measurements.
+func (in *Internal) stretchSize(block *Block, state *stretchBlockState, index int, weight float64) int {
+	if index >= len(state.lastWeights) {
+		// First frame of this stretch: nothing measured yet.
+		return 0
+	}
+
+	content := state.lastFinal
+	totalWeight := 0.0
+	for i, w := range state.lastWeights {
+		content -= state.lastAlloc[i]
+		totalWeight += w
+	}
+	// Re-weight with the current call's weight in place of the old one.
+	totalWeight += weight - state.lastWeights[index]
+
+	axis := block.Rect.Width
+	if block.Type == COLUMN {
+		axis = block.Rect.Height
+	}
+	free := axis - content
+	if free <= 0 || totalWeight <= 0 {
+		return 0
+	}
+	return int(float64(free) * weight / totalWeight)
+}
+
+// finishStretches records the final content size of a block being
+// ended, completing this frame's measurement for the next one.
+func (in *Internal) finishStretches(depth int, block *Block) {
+	for len(in.stretchScopes) > 0 {
+		scope := in.stretchScopes[len(in.stretchScopes)-1]
+		if scope.depth < depth {
+			return
+		}
+		in.stretchScopes = in.stretchScopes[:len(in.stretchScopes)-1]
+		if block.Type == ROW {
+			scope.state.lastFinal = block.Fill.Width
+		} else {
+			scope.state.lastFinal = block.Fill.Height
+		}
+	}
+}
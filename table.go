@@ -0,0 +1,466 @@
+package gocvui
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const tableRowHeight = 20
+
+// ExportFormat selects the on-disk format of exported widget data.
+type ExportFormat string
+
+// Supported export formats.
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportJSON ExportFormat = "json"
+)
+
+// TableEditor selects the editing widget used for cells of a column.
+type TableEditor int
+
+// Per-column cell editors.
+const (
+	// EditorNone makes the column read-only.
+	EditorNone TableEditor = iota
+	// EditorText edits the cell as free-form text.
+	EditorText
+	// EditorNumber edits the cell as text restricted to numeric input.
+	EditorNumber
+	// EditorDropdown picks the cell value from the column Options.
+	EditorDropdown
+	// EditorCheckbox toggles the cell between "true" and "false".
+	EditorCheckbox
+)
+
+// TableColumn describes one column of a Table.
+type TableColumn struct {
+	Title string
+	Width int
+
+	// Editor enables inline editing of the column's cells on
+	// double-click.
+	Editor TableEditor
+	// Options are the choices offered by EditorDropdown.
+	Options []string
+}
+
+// tableMinColumnWidth is the narrowest a column can be dragged to.
+const tableMinColumnWidth = 24
+
+// Table renders tabular data. Like Plot, a Table is an instance the
+// application keeps around between frames.
+type Table struct {
+	Columns []TableColumn
+	Rows    [][]string
+
+	// ID keys the table's layout in the widget state store; tables
+	// rendered at different positions may share an ID to share their
+	// layout. When empty, the render position is used instead.
+	ID string
+
+	// ShowExportButton renders an "export" button in the table header
+	// that writes the data to ExportPath when clicked.
+	ShowExportButton bool
+	ExportPath       string
+
+	// OnEdit, when set, is called after an edited cell value is
+	// committed to Rows.
+	OnEdit func(row, column int, value string)
+}
+
+// NewTable returns a table with the given columns.
+func NewTable(columns ...TableColumn) *Table {
+	return &Table{Columns: columns}
+}
+
+// tableLayout is the user-adjusted column layout. It is kept in the
+// persistent state store, so SaveState/LoadState carry it across
+// sessions.
+type tableLayout struct {
+	Widths []int
+	Order  []int
+}
+
+// tableDrag tracks an in-flight header drag, either resizing a column
+// edge or reordering a column.
+type tableDrag struct {
+	Resizing   int
+	Reordering int
+	StartX     int
+	StartWidth int
+}
+
+// Render draws the table at (x, y) and handles its interaction:
+// dragging a header edge resizes the column, dragging a header moves
+// the column, and both changes persist in the layout state.
+func (t *Table) Render(frame *gocv.Mat, x, y int) {
+	block := internal.ScreenBlock(frame)
+	mouse := &internal.GetContext("").Mouse
+
+	id := t.ID
+	if id == "" {
+		id = internal.StateID("table", x, y)
+	}
+	layout := internal.PersistentState("table.layout/"+id, func() interface{} {
+		return &tableLayout{}
+	}).(*tableLayout)
+	if len(layout.Widths) != len(t.Columns) {
+		layout.Widths = make([]int, len(t.Columns))
+		layout.Order = make([]int, len(t.Columns))
+		for j, column := range t.Columns {
+			layout.Widths[j] = column.Width
+			layout.Order[j] = j
+		}
+	}
+	drag := internal.State("table.drag/"+id, func() interface{} {
+		return &tableDrag{Resizing: -1, Reordering: -1}
+	}).(*tableDrag)
+	edit := internal.State("table.edit/"+id, func() interface{} {
+		return &tableEdit{Row: -1, Column: -1, lastRow: -1, lastColumn: -1}
+	}).(*tableEdit)
+
+	width := 0
+	for _, w := range layout.Widths {
+		width += w
+	}
+	area := Rect{x, y, width, (len(t.Rows) + 1) * tableRowHeight}
+
+	t.handleHeaderDrag(layout, drag, mouse, x, y, width)
+
+	render.rectangle(block.Where, area, 0x292929, CVUI_FILLED)
+	header := Rect{x, y, width, tableRowHeight}
+	render.rectangle(block.Where, header, 0x383838, CVUI_FILLED)
+
+	columnX := x
+	for position, j := range layout.Order {
+		column := t.Columns[j]
+		if position == drag.Reordering {
+			render.rectangle(block.Where, Rect{columnX, y, layout.Widths[j], tableRowHeight}, 0x3E4348, CVUI_FILLED)
+		}
+		size := render.GetTextSize(column.Title, 0.4)
+		render.putText(block.Where, column.Title, Point{columnX + 4, y + tableRowHeight/2 + size.Height/2}, 0.4, theme.TextColor)
+		columnX += layout.Widths[j]
+		render.line(block.Where, Point{columnX, y}, Point{columnX, y + area.Height}, 0x45494A)
+	}
+
+	for i, row := range t.Rows {
+		rowY := y + (i+1)*tableRowHeight
+		columnX = x
+		for _, j := range layout.Order {
+			cell := Rect{columnX, rowY, layout.Widths[j], tableRowHeight}
+			t.handleCellClick(edit, i, j, cell)
+			if edit.Row == i && edit.Column == j {
+				t.renderCellEditor(block, edit, cell, i, j)
+			} else if j < len(row) {
+				size := render.GetTextSize(row[j], 0.4)
+				render.putText(block.Where, row[j], Point{columnX + 4, rowY + tableRowHeight/2 + size.Height/2}, 0.4, theme.TextColor)
+			}
+			columnX += layout.Widths[j]
+		}
+		render.line(block.Where, Point{x, rowY}, Point{x + width, rowY}, 0x383838)
+	}
+	render.rectangle(block.Where, area, 0x45494A, 1)
+
+	if t.ShowExportButton {
+		if internal.Button(block, x+width-20, y+2, 18, 16, "v", false) {
+			path := t.ExportPath
+			if path == "" {
+				path = "table.csv"
+			}
+			if err := t.Export(path, formatForPath(path)); err != nil {
+				internal.WarnOnce("table-export-"+path, "could not export table", "path", path, "error", err)
+			}
+		}
+	}
+}
+
+// handleHeaderDrag updates the layout from resize and reorder drags on
+// the header row.
+func (t *Table) handleHeaderDrag(layout *tableLayout, drag *tableDrag, mouse *Mouse, x, y, width int) {
+	if drag.Resizing >= 0 {
+		j := layout.Order[drag.Resizing]
+		next := layout.StartWidthPlus(drag, mouse.Position.X)
+		if next < tableMinColumnWidth {
+			next = tableMinColumnWidth
+		}
+		layout.Widths[j] = next
+		if !mouse.AnyButton.Pressed {
+			drag.Resizing = -1
+		}
+		return
+	}
+
+	if drag.Reordering >= 0 {
+		if !mouse.AnyButton.Pressed {
+			if target := layout.positionAt(x, mouse.Position.X); target >= 0 && target != drag.Reordering {
+				moved := layout.Order[drag.Reordering]
+				layout.Order = append(layout.Order[:drag.Reordering], layout.Order[drag.Reordering+1:]...)
+				layout.Order = append(layout.Order[:target], append([]int{moved}, layout.Order[target:]...)...)
+			}
+			drag.Reordering = -1
+		}
+		return
+	}
+
+	if !mouse.AnyButton.JustPressed || mouse.Position.Y < y || mouse.Position.Y >= y+tableRowHeight {
+		return
+	}
+
+	// A press near a column edge starts a resize; elsewhere on a header
+	// it starts a reorder.
+	columnX := x
+	for position, j := range layout.Order {
+		columnX += layout.Widths[j]
+		if mouse.Position.X >= columnX-3 && mouse.Position.X <= columnX+3 {
+			drag.Resizing = position
+			drag.StartX = mouse.Position.X
+			drag.StartWidth = layout.Widths[j]
+			return
+		}
+	}
+	if position := layout.positionAt(x, mouse.Position.X); position >= 0 {
+		drag.Reordering = position
+	}
+}
+
+// tableEdit tracks the cell being edited and double-click detection.
+type tableEdit struct {
+	Row    int
+	Column int
+	Input  TextInputState
+
+	lastClickAt time.Duration
+	lastRow     int
+	lastColumn  int
+}
+
+// handleCellClick starts editing a cell when it is double-clicked.
+func (t *Table) handleCellClick(edit *tableEdit, row, column int, cell Rect) {
+	if t.Columns[column].Editor == EditorNone {
+		return
+	}
+	if internal.IArea(cell.X, cell.Y, cell.Width, cell.Height) != CLICK {
+		return
+	}
+
+	now := internal.Clock.Now()
+	if edit.lastRow == row && edit.lastColumn == column && now-edit.lastClickAt < 400*time.Millisecond {
+		t.beginEdit(edit, row, column)
+		edit.lastRow, edit.lastColumn = -1, -1
+		return
+	}
+	edit.lastRow, edit.lastColumn, edit.lastClickAt = row, column, now
+}
+
+func (t *Table) beginEdit(edit *tableEdit, row, column int) {
+	if t.Columns[column].Editor == EditorCheckbox {
+		value := "true"
+		if t.cellValue(row, column) == "true" {
+			value = "false"
+		}
+		t.commitEdit(row, column, value)
+		return
+	}
+	edit.Row, edit.Column = row, column
+	edit.Input.Text = t.cellValue(row, column)
+}
+
+// renderCellEditor draws the editor of the cell being edited and feeds
+// it the keyboard/mouse input of the frame.
+func (t *Table) renderCellEditor(block *Block, edit *tableEdit, cell Rect, row, column int) {
+	if t.Columns[column].Editor == EditorDropdown {
+		t.renderDropdownEditor(block, edit, cell, row, column)
+		return
+	}
+
+	switch edit.Input.Feed(internal.LastKeyPressed, t.Columns[column].Editor == EditorNumber) {
+	case EDIT_COMMIT:
+		t.commitEdit(row, column, edit.Input.Text)
+		edit.Row, edit.Column = -1, -1
+		return
+	case EDIT_CANCEL:
+		edit.Row, edit.Column = -1, -1
+		return
+	}
+	render.EditBox(block, cell, edit.Input.Text)
+}
+
+func (t *Table) renderDropdownEditor(block *Block, edit *tableEdit, cell Rect, row, column int) {
+	options := t.Columns[column].Options
+	list := Rect{cell.X, cell.Y + cell.Height, cell.Width, len(options) * tableRowHeight}
+
+	hovered := -1
+	for k := range options {
+		optionRect := Rect{list.X, list.Y + k*tableRowHeight, list.Width, tableRowHeight}
+		status := internal.IArea(optionRect.X, optionRect.Y, optionRect.Width, optionRect.Height)
+		if status == OVER || status == DOWN {
+			hovered = k
+		}
+		if status == CLICK {
+			t.commitEdit(row, column, options[k])
+			edit.Row, edit.Column = -1, -1
+			return
+		}
+	}
+
+	mouse := &internal.GetContext("").Mouse
+	if internal.LastKeyPressed == 27 || (mouse.AnyButton.JustReleased && !mouse.Position.Inside(list) && !mouse.Position.Inside(cell)) {
+		edit.Row, edit.Column = -1, -1
+		return
+	}
+
+	render.EditBox(block, cell, t.cellValue(row, column))
+	hoveredNow := hovered
+	internal.QueueOverlay(func() {
+		render.rectangle(block.Where, list, 0x292929, CVUI_FILLED)
+		render.rectangle(block.Where, list, 0x45494A, 1)
+		for k, option := range options {
+			optionRect := Rect{list.X, list.Y + k*tableRowHeight, list.Width, tableRowHeight}
+			if k == hoveredNow {
+				render.rectangle(block.Where, optionRect, 0x3E4348, CVUI_FILLED)
+			}
+			size := render.GetTextSize(option, 0.4)
+			render.putText(block.Where, option, Point{optionRect.X + 4, optionRect.Y + tableRowHeight/2 + size.Height/2}, 0.4, theme.TextColor)
+		}
+	})
+}
+
+func (t *Table) cellValue(row, column int) string {
+	if column < len(t.Rows[row]) {
+		return t.Rows[row][column]
+	}
+	return ""
+}
+
+func (t *Table) commitEdit(row, column int, value string) {
+	for len(t.Rows[row]) <= column {
+		t.Rows[row] = append(t.Rows[row], "")
+	}
+	t.Rows[row][column] = value
+	if t.OnEdit != nil {
+		t.OnEdit(row, column, value)
+	}
+}
+
+// StartWidthPlus returns the drag start width adjusted by how far the
+// mouse has moved.
+func (l *tableLayout) StartWidthPlus(drag *tableDrag, mouseX int) int {
+	return drag.StartWidth + mouseX - drag.StartX
+}
+
+// positionAt returns the visual column position under mouseX, or -1.
+func (l *tableLayout) positionAt(x, mouseX int) int {
+	columnX := x
+	for position, j := range l.Order {
+		if mouseX >= columnX && mouseX < columnX+l.Widths[j] {
+			return position
+		}
+		columnX += l.Widths[j]
+	}
+	return -1
+}
+
+// Export writes the table data to path in the given format. The CSV
+// output has the column titles as its first record; the JSON output is
+// an array of objects keyed by column title.
+func (t *Table) Export(path string, format ExportFormat) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if format == ExportJSON {
+		records := make([]map[string]string, 0, len(t.Rows))
+		for _, row := range t.Rows {
+			record := map[string]string{}
+			for j, column := range t.Columns {
+				if j < len(row) {
+					record[column.Title] = row[j]
+				}
+			}
+			records = append(records, record)
+		}
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	}
+
+	writer := csv.NewWriter(file)
+	titles := make([]string, len(t.Columns))
+	for j, column := range t.Columns {
+		titles[j] = column.Title
+	}
+	if err := writer.Write(titles); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(t.Rows); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ExportData writes the plot series to path, as CSV (one column per
+// series) when the path ends in ".csv" and as JSON otherwise.
+func (p *Plot) ExportData(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if formatForPath(path) == ExportJSON {
+		data := map[string][]float64{}
+		for _, series := range p.Series {
+			data[series.Label] = series.Values
+		}
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	}
+
+	writer := csv.NewWriter(file)
+	titles := make([]string, len(p.Series))
+	longest := 0
+	for j, series := range p.Series {
+		titles[j] = series.Label
+		if len(series.Values) > longest {
+			longest = len(series.Values)
+		}
+	}
+	if err := writer.Write(titles); err != nil {
+		return err
+	}
+	for i := 0; i < longest; i++ {
+		record := make([]string, len(p.Series))
+		for j, series := range p.Series {
+			if i < len(series.Values) {
+				record[j] = formatFloat(series.Values[i])
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func formatForPath(path string) ExportFormat {
+	if strings.HasSuffix(strings.ToLower(path), ".json") {
+		return ExportJSON
+	}
+	return ExportCSV
+}
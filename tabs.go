@@ -0,0 +1,105 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const tabHeaderHeight = 24
+
+// tabsScope tracks the tab bar between BeginTabs and EndTabs.
+type tabsScope struct {
+	frame     *gocv.Mat
+	area      Rect
+	active    *int
+	index     int
+	nextX     int
+	blockOpen bool
+}
+
+var activeTabs *tabsScope
+
+// BeginTabs starts a tab bar at (x, y): a row of clickable headers
+// declared by subsequent Tab calls, sharing one content region below.
+// The index of the active tab is bound to *active. Tab bars cannot be
+// nested:
+//
+//	BeginTabs(frame, x, y, width, &active)
+//	if Tab("Input") { ... }
+//	if Tab("Output") { ... }
+//	EndTabs()
+func BeginTabs(frame *gocv.Mat, x, y, width int, active *int) {
+	if activeTabs != nil {
+		internal.Fail(ErrStackMismatch, "BeginTabs", "tab bars cannot be nested")
+		return
+	}
+	activeTabs = &tabsScope{
+		frame:  frame,
+		area:   Rect{x, y, width, tabHeaderHeight},
+		active: active,
+		nextX:  x,
+	}
+
+	block := internal.ScreenBlock(frame)
+	render.line(block.Where, Point{x, y + tabHeaderHeight - 1}, Point{x + width, y + tabHeaderHeight - 1}, 0x45494A)
+}
+
+// Tab declares the next tab of the enclosing tab bar and returns true
+// when it is the active one; only then are the components rendered
+// inside the `if` body laid out, in a column below the headers.
+func Tab(label string) bool {
+	scope := activeTabs
+	if scope == nil {
+		internal.Fail(ErrStackMismatch, "Tab", "missing BeginTabs()")
+		return false
+	}
+	if scope.blockOpen {
+		internal.EndBlock()
+		scope.blockOpen = false
+	}
+
+	index := scope.index
+	scope.index++
+
+	size := render.GetTextSize(label, 0.4)
+	header := Rect{scope.nextX, scope.area.Y, size.Width + 20, tabHeaderHeight}
+	scope.nextX += header.Width
+
+	block := internal.ScreenBlock(scope.frame)
+	status := internal.IArea(header.X, header.Y, header.Width, header.Height)
+	if status == CLICK {
+		*scope.active = index
+	}
+
+	selected := *scope.active == index
+	fill := uint32(0x292929)
+	if selected {
+		fill = 0x424242
+	} else if status == OVER || status == DOWN {
+		fill = 0x333333
+	}
+	render.rectangle(block.Where, Rect{header.X, header.Y, header.Width, header.Height - 1}, fill, CVUI_FILLED)
+	textColor := uint32(0x767676)
+	if selected {
+		textColor = theme.TextColor
+	}
+	render.putText(block.Where, label, Point{header.X + 10, header.Y + tabHeaderHeight/2 + size.Height/2}, 0.4, textColor)
+
+	if selected {
+		internal.BeginBlock(scope.frame, scope.area.X, scope.area.Y+tabHeaderHeight+4, scope.area.Width, -1, 4, COLUMN)
+		scope.blockOpen = true
+	}
+	return selected
+}
+
+// EndTabs closes a tab bar started by BeginTabs.
+func EndTabs() {
+	scope := activeTabs
+	if scope == nil {
+		internal.Fail(ErrStackMismatch, "EndTabs", "missing BeginTabs()")
+		return
+	}
+	if scope.blockOpen {
+		internal.EndBlock()
+	}
+	activeTabs = nil
+}
@@ -0,0 +1,120 @@
+package gocvui
+
+import (
+	"fmt"
+	"sync"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	taskBarHeight     = 15
+	taskCancelWidth   = 60
+	taskCancelHeight  = 22
+	taskLabelPadding  = 4
+	taskRenderHeight  = 40
+	taskProgressDepth = 16
+)
+
+// TaskHandle is the UI-side view of a background task started by Task.
+// All accessors are safe to call from the UI thread while the task
+// goroutine is still running.
+type TaskHandle struct {
+	Name string
+
+	mu       sync.Mutex
+	progress float64
+	done     bool
+	canceled bool
+}
+
+// Task runs fn in a goroutine, reporting progress in the 0..1 range
+// through the channel it is handed. The channel is closed by the
+// library once fn returns; fn must not close it. The returned handle
+// marshals progress and completion back to the UI thread safely and
+// renders as a ready-made progress bar with a cancel button. Long
+// tasks that want to honor the cancel button should poll Canceled()
+// and return early.
+func Task(name string, fn func(progress chan<- float64)) *TaskHandle {
+	t := &TaskHandle{Name: name}
+	progress := make(chan float64, taskProgressDepth)
+
+	go func() {
+		fn(progress)
+		close(progress)
+	}()
+	go func() {
+		for p := range progress {
+			t.mu.Lock()
+			t.progress = p
+			t.mu.Unlock()
+		}
+		t.mu.Lock()
+		t.done = true
+		t.mu.Unlock()
+	}()
+	return t
+}
+
+// Progress returns the last value reported by the task, in 0..1.
+func (t *TaskHandle) Progress() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.progress
+}
+
+// Done reports whether the task function has returned.
+func (t *TaskHandle) Done() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.done
+}
+
+// Cancel asks the task to stop; the task function observes it through
+// Canceled.
+func (t *TaskHandle) Cancel() {
+	t.mu.Lock()
+	t.canceled = true
+	t.mu.Unlock()
+}
+
+// Canceled reports whether Cancel has been called.
+func (t *TaskHandle) Canceled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.canceled
+}
+
+// Render draws the task's name, a progress bar and a cancel button at
+// (x, y). It returns true once the task is done or canceled, at which
+// point the caller can stop rendering it.
+func (t *TaskHandle) Render(frame *gocv.Mat, x, y, width int) bool {
+	block := internal.ScreenBlock(frame)
+
+	t.mu.Lock()
+	progress := t.progress
+	done := t.done
+	canceled := t.canceled
+	t.mu.Unlock()
+
+	label := fmt.Sprintf("%s (%.0f%%)", t.Name, progress*100)
+	if canceled {
+		label = t.Name + " (canceling...)"
+	}
+	size := render.GetTextSize(label, 0.4)
+	render.putText(block.Where, label, Point{x, y + size.Height}, 0.4, theme.TextColor)
+
+	barWidth := width - taskCancelWidth - taskLabelPadding
+	bar := Rect{x, y + size.Height + taskLabelPadding, barWidth, taskBarHeight}
+	render.rectangle(block.Where, bar, ColorInset, CVUI_FILLED)
+	if progress > 0 {
+		fill := Rect{bar.X, bar.Y, int(float64(bar.Width) * clampFloat(progress, 0, 1)), bar.Height}
+		render.rectangle(block.Where, fill, theme.CheckboxCheck, CVUI_FILLED)
+	}
+	render.rectangle(block.Where, bar, ColorBorder, 1)
+
+	if !canceled && internal.Button(block, x+barWidth+taskLabelPadding, bar.Y-(taskCancelHeight-taskBarHeight)/2, taskCancelWidth, taskCancelHeight, "Cancel", false) {
+		t.Cancel()
+	}
+	return done || canceled
+}
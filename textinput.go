@@ -0,0 +1,52 @@
+package gocvui
+
+// Results of feeding a key into a TextInputState.
+const (
+	EDIT_CONTINUE = iota
+	EDIT_COMMIT
+	EDIT_CANCEL
+)
+
+// TextInputState is the minimal text-input machinery shared by widgets
+// that edit text inline. Keys come from the internal waitKey loop, so a
+// positive delayWaitKey in Init is required for editing to work.
+type TextInputState struct {
+	Text string
+}
+
+// Feed consumes a key as returned by waitKey and updates the text.
+// Returns EDIT_COMMIT on enter, EDIT_CANCEL on escape and EDIT_CONTINUE
+// otherwise. With numeric set, only characters that can appear in a
+// number are accepted.
+func (s *TextInputState) Feed(key int, numeric bool) int {
+	switch {
+	case key == 13 || key == 10:
+		return EDIT_COMMIT
+	case key == 27:
+		return EDIT_CANCEL
+	case key == 8 || key == 127:
+		if len(s.Text) > 0 {
+			s.Text = s.Text[:len(s.Text)-1]
+		}
+	case key >= 32 && key < 127:
+		c := byte(key)
+		if numeric && !(c >= '0' && c <= '9' || c == '-' || c == '+' || c == '.' || c == 'e') {
+			break
+		}
+		s.Text += string(c)
+	}
+	return EDIT_CONTINUE
+}
+
+// EditBox renders an inline text editor with a caret inside rect.
+func (r *Render) EditBox(block *Block, rect Rect, text string) {
+	r.rectangle(block.Where, rect, 0x1E1E1E, CVUI_FILLED)
+	r.rectangle(block.Where, rect, theme.CheckboxCheck, 1)
+
+	size := r.GetTextSize(text, 0.4)
+	position := Point{rect.X + 4, rect.Y + rect.Height/2 + size.Height/2}
+	r.putText(block.Where, text, position, 0.4, theme.TextColor)
+
+	caretX := position.X + size.Width + 2
+	r.line(block.Where, Point{caretX, rect.Y + 3}, Point{caretX, rect.Y + rect.Height - 3}, theme.TextColor)
+}
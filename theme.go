@@ -0,0 +1,52 @@
+package gocvui
+
+// Theme controls the colors and effects used by the rendering layer.
+type Theme struct {
+	ButtonBorder   uint32
+	ButtonFillOut  uint32
+	ButtonFillOver uint32
+	ButtonFillDown uint32
+
+	CheckboxBorderOut  uint32
+	CheckboxBorderOver uint32
+	CheckboxFill       uint32
+	CheckboxCheck      uint32
+
+	TextColor uint32
+
+	TitleBarActive   uint32
+	TitleBarInactive uint32
+
+	// DisableAnimations renders state changes instantly instead of
+	// interpolating them over time.
+	DisableAnimations bool
+}
+
+var theme = DefaultTheme()
+
+// DefaultTheme returns the stock dark theme.
+func DefaultTheme() Theme {
+	return Theme{
+		ButtonBorder:       0x29313B,
+		ButtonFillOut:      0x424242,
+		ButtonFillOver:     0x515151,
+		ButtonFillDown:     0x2E2E2E,
+		CheckboxBorderOut:  0x454545,
+		CheckboxBorderOver: 0x626262,
+		CheckboxFill:       0x292929,
+		CheckboxCheck:      0xFFBC00,
+		TextColor:          0xCECECE,
+		TitleBarActive:     0x4A4A4A,
+		TitleBarInactive:   0x3A3A3A,
+	}
+}
+
+// SetTheme replaces the active theme.
+func SetTheme(t Theme) {
+	theme = t
+}
+
+// GetTheme returns the active theme.
+func GetTheme() Theme {
+	return theme
+}
@@ -0,0 +1,135 @@
+package gocvui
+
+import (
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	timelineButtonSize = 16
+	timelineMaxZoom    = 64.0
+	timelineZoomStep   = 2.0
+)
+
+// TimelineMarker is a single-frame event on a Timeline, e.g. one
+// detection.
+type TimelineMarker struct {
+	Frame int
+	Color uint32
+}
+
+// TimelineRegion is a frame span on a Timeline, e.g. a labeled
+// segment.
+type TimelineRegion struct {
+	From  int
+	To    int
+	Color uint32
+}
+
+// TimelineState is the application-owned state of a Timeline.
+type TimelineState struct {
+	// Position is the cursor frame.
+	Position int
+	// Total is the frame count of the timeline.
+	Total int
+	// Markers and Regions are drawn inside the band.
+	Markers []TimelineMarker
+	// Regions are drawn behind the markers.
+	Regions []TimelineRegion
+
+	zoom     float64
+	offset   float64
+	dragging bool
+}
+
+// Timeline renders a horizontal timeline band with a draggable
+// position cursor, colored markers and regions, click-to-seek and
+// +/- zoom buttons that keep the cursor centered — the navigation
+// strip of video annotation tools. Returns true when Position changed.
+func Timeline(frame *gocv.Mat, x, y, width, height int, state *TimelineState) bool {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	if state.Total <= 0 {
+		return false
+	}
+	if state.zoom < 1 {
+		state.zoom = 1
+	}
+	before := state.Position
+
+	band := Rect{x, y, width - 2*(timelineButtonSize+2), height}
+	span := float64(state.Total) / state.zoom
+	state.offset = clampFloat(state.offset, 0, float64(state.Total)-span)
+
+	render.rectangle(block.Where, band, ColorInset, CVUI_FILLED)
+	for _, region := range state.Regions {
+		from := timelineFrameToX(state, band, region.From)
+		to := timelineFrameToX(state, band, region.To)
+		if to < band.X || from > band.X+band.Width {
+			continue
+		}
+		from = clampInt(from, band.X, band.X+band.Width)
+		to = clampInt(to, band.X, band.X+band.Width)
+		render.rectangle(block.Where, Rect{from, band.Y + 2, maxInt(to-from, 1), band.Height - 4}, region.Color, CVUI_FILLED)
+	}
+	for _, marker := range state.Markers {
+		markerX := timelineFrameToX(state, band, marker.Frame)
+		if markerX < band.X || markerX > band.X+band.Width {
+			continue
+		}
+		render.line(block.Where, Point{markerX, band.Y + 2}, Point{markerX, band.Y + band.Height - 2}, marker.Color)
+	}
+	render.rectangle(block.Where, band, ColorBorder, 1)
+
+	if mouse.AnyButton.JustPressed && band.Contains(mouse.Position) {
+		state.dragging = true
+	}
+	if state.dragging {
+		if !mouse.AnyButton.Pressed {
+			state.dragging = false
+		} else {
+			state.Position = timelineXToFrame(state, band, mouse.Position.X)
+		}
+	}
+	state.Position = clampInt(state.Position, 0, state.Total-1)
+
+	cursorX := timelineFrameToX(state, band, state.Position)
+	if cursorX >= band.X && cursorX <= band.X+band.Width {
+		render.line(block.Where, Point{cursorX, band.Y}, Point{cursorX, band.Y + band.Height}, ColorHighlight)
+		render.circle(block.Where, Point{cursorX, band.Y}, 3, ColorHighlight, CVUI_FILLED)
+	}
+	label := fmt.Sprintf("%d", state.Position)
+	render.putText(block.Where, label, Point{clampInt(cursorX+3, band.X, band.X+band.Width-20), band.Y + band.Height - 4}, 0.3, theme.TextColor)
+
+	zoomX := band.X + band.Width + 2
+	if internal.Button(block, zoomX, y, timelineButtonSize, timelineButtonSize, "+", false) && state.zoom < timelineMaxZoom {
+		timelineZoomAround(state, state.zoom*timelineZoomStep)
+	}
+	if internal.Button(block, zoomX+timelineButtonSize+2, y, timelineButtonSize, timelineButtonSize, "-", false) {
+		timelineZoomAround(state, state.zoom/timelineZoomStep)
+	}
+
+	return state.Position != before
+}
+
+// timelineZoomAround changes the zoom keeping the cursor centered in
+// the view.
+func timelineZoomAround(state *TimelineState, zoom float64) {
+	state.zoom = clampFloat(zoom, 1, timelineMaxZoom)
+	span := float64(state.Total) / state.zoom
+	state.offset = clampFloat(float64(state.Position)-span/2, 0, float64(state.Total)-span)
+}
+
+// timelineFrameToX maps a frame index to a band x coordinate.
+func timelineFrameToX(state *TimelineState, band Rect, frame int) int {
+	span := float64(state.Total) / state.zoom
+	return band.X + int((float64(frame)-state.offset)/span*float64(band.Width))
+}
+
+// timelineXToFrame maps a band x coordinate back to a frame index.
+func timelineXToFrame(state *TimelineState, band Rect, x int) int {
+	span := float64(state.Total) / state.zoom
+	return int(state.offset + float64(x-band.X)/float64(band.Width)*span)
+}
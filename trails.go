@@ -0,0 +1,129 @@
+package gocvui
+
+import (
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	trailDefaultLength = 32
+	trailPruneFrames   = 120
+	trailArrowScale    = 3
+	trailArrowHead     = 4
+)
+
+// trailPalette is cycled through by track ID so each object keeps a
+// stable color.
+var trailPalette = []uint32{
+	0xFFBC00, 0x00A5FF, 0x7CFC00, 0xFF6347, 0xBA55D3, 0x40E0D0, 0xFFD700, 0xFF69B4,
+}
+
+// Track is the current observation of one tracked object, identified
+// across frames by its ID.
+type Track struct {
+	ID       int
+	Position Point
+}
+
+// TrailOptions tunes the Trails visualization.
+type TrailOptions struct {
+	// Length is the number of recent positions kept per track; zero
+	// means 32.
+	Length int
+	// VelocityArrows extrapolates each track's latest motion as an
+	// arrow.
+	VelocityArrows bool
+}
+
+// trailHistory is the ring buffer of recent positions of one track.
+type trailHistory struct {
+	Points   []Point
+	Next     int
+	Count    int
+	LastSeen int
+}
+
+// trailsState holds the history ring buffers of all tracks.
+type trailsState struct {
+	Tracks map[int]*trailHistory
+}
+
+// Trails draws fading polylines of each tracked object's recent
+// positions with per-ID colors and optional velocity arrows. The
+// position history is maintained internally: feed it the current
+// tracks every frame and stale IDs are pruned automatically.
+func Trails(frame *gocv.Mat, tracks []Track, opts TrailOptions) {
+	block := internal.ScreenBlock(frame)
+
+	length := opts.Length
+	if length <= 0 {
+		length = trailDefaultLength
+	}
+
+	state := internal.State(internal.StateID("trails", 0, 0), func() interface{} {
+		return &trailsState{Tracks: map[int]*trailHistory{}}
+	}).(*trailsState)
+
+	now := internal.Clock.Frame()
+	for _, track := range tracks {
+		history, ok := state.Tracks[track.ID]
+		if !ok || len(history.Points) != length {
+			history = &trailHistory{Points: make([]Point, length)}
+			state.Tracks[track.ID] = history
+		}
+		history.Points[history.Next] = track.Position
+		history.Next = (history.Next + 1) % length
+		if history.Count < length {
+			history.Count++
+		}
+		history.LastSeen = now
+	}
+	for id, history := range state.Tracks {
+		if now-history.LastSeen > trailPruneFrames {
+			delete(state.Tracks, id)
+		}
+	}
+
+	for _, track := range tracks {
+		history := state.Tracks[track.ID]
+		hexColor := trailPalette[((track.ID%len(trailPalette))+len(trailPalette))%len(trailPalette)]
+		renderTrail(block, history, hexColor, opts.VelocityArrows)
+	}
+}
+
+// renderTrail draws one track's polyline, fading toward its oldest
+// point, plus the optional velocity arrow.
+func renderTrail(block *Block, history *trailHistory, hexColor uint32, arrow bool) {
+	length := len(history.Points)
+	oldest := (history.Next - history.Count + length) % length
+
+	var previous Point
+	for i := 0; i < history.Count; i++ {
+		point := history.Points[(oldest+i)%length]
+		if i > 0 {
+			fade := float64(i) / float64(history.Count)
+			render.line(block.Where, previous, point, lerpColor(ColorPanel, hexColor, fade))
+		}
+		previous = point
+	}
+
+	if arrow && history.Count >= 2 {
+		latest := history.Points[(history.Next-1+length)%length]
+		before := history.Points[(history.Next-2+length)%length]
+		dx := float64(latest.X-before.X) * trailArrowScale
+		dy := float64(latest.Y-before.Y) * trailArrowScale
+		if dx == 0 && dy == 0 {
+			return
+		}
+		tip := Point{latest.X + int(dx), latest.Y + int(dy)}
+		render.line(block.Where, latest, tip, hexColor)
+
+		angle := math.Atan2(dy, dx)
+		for _, side := range []float64{-1, 1} {
+			wing := angle + math.Pi + side*math.Pi/6
+			end := Point{tip.X + int(trailArrowHead*math.Cos(wing)), tip.Y + int(trailArrowHead*math.Sin(wing))}
+			render.line(block.Where, tip, end, hexColor)
+		}
+	}
+}
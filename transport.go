@@ -0,0 +1,115 @@
+package gocvui
+
+import (
+	"fmt"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	transportButtonSize = 22
+	transportButtonGap  = 4
+	transportSpeedWidth = 44
+)
+
+// transportSpeeds are the playback rates the speed button cycles
+// through.
+var transportSpeeds = []float64{0.25, 0.5, 1, 2, 4}
+
+// TransportState is the application-owned state of a Transport bar,
+// designed to drive a gocv.VideoCapture loop: when Transport returns
+// true, seek or decode to Frame.
+type TransportState struct {
+	// Playing is true while playback advances.
+	Playing bool
+	// Frame is the requested frame index.
+	Frame int
+	// Total is the frame count of the video.
+	Total int
+	// FPS is the playback rate of the source; zero means 30.
+	FPS float64
+	// Speed is the playback rate multiplier; zero means 1.
+	Speed float64
+
+	accumulated time.Duration
+}
+
+// Transport renders a playback control bar — play/pause, single-frame
+// steps, a speed cycle button and a seek trackbar with the
+// current-frame label — and advances Frame by the frame clock while
+// playing. Returns true when Frame changed, i.e. the caller should
+// seek or decode.
+func Transport(frame *gocv.Mat, x, y, width int, state *TransportState) bool {
+	block := internal.ScreenBlock(frame)
+
+	if state.Speed == 0 {
+		state.Speed = 1
+	}
+	fps := state.FPS
+	if fps <= 0 {
+		fps = 30
+	}
+
+	before := state.Frame
+
+	label := ">"
+	if state.Playing {
+		label = "||"
+	}
+	buttonX := x
+	if internal.Button(block, buttonX, y, transportButtonSize, transportButtonSize, "<<", false) {
+		state.Playing = false
+		state.Frame--
+	}
+	buttonX += transportButtonSize + transportButtonGap
+	if internal.Button(block, buttonX, y, transportButtonSize, transportButtonSize, label, false) {
+		state.Playing = !state.Playing
+		state.accumulated = 0
+	}
+	buttonX += transportButtonSize + transportButtonGap
+	if internal.Button(block, buttonX, y, transportButtonSize, transportButtonSize, ">>", false) {
+		state.Playing = false
+		state.Frame++
+	}
+	buttonX += transportButtonSize + transportButtonGap
+	if internal.Button(block, buttonX, y, transportSpeedWidth, transportButtonSize, fmt.Sprintf("%gx", state.Speed), false) {
+		state.Speed = nextTransportSpeed(state.Speed)
+	}
+	buttonX += transportSpeedWidth + transportButtonGap
+
+	if state.Playing {
+		state.accumulated += time.Duration(float64(internal.Clock.Delta()) * state.Speed)
+		frameTime := time.Duration(float64(time.Second) / fps)
+		for state.accumulated >= frameTime {
+			state.accumulated -= frameTime
+			state.Frame++
+		}
+	}
+
+	if state.Total > 0 {
+		position := float64(state.Frame)
+		params := TrackbarParams{Min: 0, Max: float64(state.Total - 1), LabelFormat: "%.0f", Options: TRACKBAR_HIDE_LABELS}
+		if internal.Trackbar(block, buttonX, y-4, width-(buttonX-x), &position, params, false) {
+			state.Playing = false
+			state.Frame = int(position)
+		}
+		counter := fmt.Sprintf("%d / %d", state.Frame, state.Total-1)
+		internal.Text(block, buttonX+4, y+transportButtonSize+2, counter, 0.35, ColorDimText, false)
+		state.Frame = clampInt(state.Frame, 0, state.Total-1)
+	} else if state.Frame < 0 {
+		state.Frame = 0
+	}
+
+	return state.Frame != before
+}
+
+// nextTransportSpeed returns the rate after current in the cycle.
+func nextTransportSpeed(current float64) float64 {
+	for i, speed := range transportSpeeds {
+		if speed == current {
+			return transportSpeeds[(i+1)%len(transportSpeeds)]
+		}
+	}
+	return 1
+}
@@ -0,0 +1,143 @@
+package gocvui
+
+import (
+	"strings"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	treeRowHeight = 18
+	treeIndent    = 14
+)
+
+// TreeNode is one entry in a Tree.
+type TreeNode struct {
+	Label    string
+	Children []*TreeNode
+	Expanded bool
+
+	// HasChildren marks a node as expandable even though its children
+	// have not been loaded yet; they are requested through the tree's
+	// OnExpand callback on first expansion.
+	HasChildren bool
+
+	loaded bool
+}
+
+// Tree renders a hierarchical list of expandable nodes, e.g. a file
+// system or a detection result hierarchy. The application keeps the
+// instance around between frames.
+type Tree struct {
+	Roots []*TreeNode
+
+	// Selected is the node last clicked by the user.
+	Selected *TreeNode
+
+	// OnExpand, when set, is called the first time a node flagged with
+	// HasChildren is expanded and must return its children, enabling
+	// lazy loading of large hierarchies.
+	OnExpand func(node *TreeNode) []*TreeNode
+
+	// Filter hides branches whose labels do not contain it
+	// (case-insensitively), while keeping the ancestors of matching
+	// nodes visible.
+	Filter string
+}
+
+// NewTree returns an empty tree.
+func NewTree() *Tree {
+	return &Tree{}
+}
+
+// Render draws the tree at (x, y) and handles expansion, lazy loading
+// and selection. Returns true when the selection changes.
+func (t *Tree) Render(frame *gocv.Mat, x, y, width int) bool {
+	block := internal.ScreenBlock(frame)
+	changed := false
+	rowY := y
+
+	var walk func(nodes []*TreeNode, depth int)
+	walk = func(nodes []*TreeNode, depth int) {
+		for _, node := range nodes {
+			if !t.visible(node) {
+				continue
+			}
+
+			row := Rect{x, rowY, width, treeRowHeight}
+			labelX := x + 4 + depth*treeIndent
+			status := internal.IArea(row.X, row.Y, row.Width, row.Height)
+
+			if status == OVER || status == DOWN {
+				render.rectangle(block.Where, row, 0x3E4348, CVUI_FILLED)
+			}
+			if node == t.Selected {
+				render.rectangle(block.Where, row, 0x313131, CVUI_FILLED)
+				render.rectangle(block.Where, row, 0x45494A, 1)
+			}
+
+			expandable := node.HasChildren || len(node.Children) > 0
+			if expandable {
+				t.renderExpander(block, labelX, rowY, node.Expanded)
+			}
+
+			if status == CLICK {
+				mouse := internal.GetContext("").Mouse
+				if expandable && mouse.Position.X < labelX+12 {
+					t.toggle(node)
+				} else if t.Selected != node {
+					t.Selected = node
+					changed = true
+				}
+			}
+
+			size := render.GetTextSize(node.Label, 0.4)
+			render.putText(block.Where, node.Label, Point{labelX + 14, rowY + treeRowHeight/2 + size.Height/2}, 0.4, theme.TextColor)
+			rowY += treeRowHeight
+
+			if node.Expanded {
+				walk(node.Children, depth+1)
+			}
+		}
+	}
+	walk(t.Roots, 0)
+	return changed
+}
+
+// toggle expands or collapses a node, loading its children on first
+// expansion when the tree has an OnExpand callback.
+func (t *Tree) toggle(node *TreeNode) {
+	node.Expanded = !node.Expanded
+	if node.Expanded && !node.loaded && node.HasChildren && t.OnExpand != nil {
+		node.Children = t.OnExpand(node)
+		node.loaded = true
+	}
+}
+
+// visible applies the filter: a node stays visible if its label matches
+// or any loaded descendant does.
+func (t *Tree) visible(node *TreeNode) bool {
+	if t.Filter == "" {
+		return true
+	}
+	if strings.Contains(strings.ToLower(node.Label), strings.ToLower(t.Filter)) {
+		return true
+	}
+	for _, child := range node.Children {
+		if t.visible(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Tree) renderExpander(block *Block, x, rowY int, expanded bool) {
+	midY := rowY + treeRowHeight/2
+	if expanded {
+		render.line(block.Where, Point{x, midY - 2}, Point{x + 4, midY + 2}, theme.TextColor)
+		render.line(block.Where, Point{x + 4, midY + 2}, Point{x + 8, midY - 2}, theme.TextColor)
+	} else {
+		render.line(block.Where, Point{x + 2, midY - 4}, Point{x + 6, midY}, theme.TextColor)
+		render.line(block.Where, Point{x + 6, midY}, Point{x + 2, midY + 4}, theme.TextColor)
+	}
+}
@@ -0,0 +1,71 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// This file is the typed counterpart of the variadic component API in
+// gocvui.go. The variadic functions remain as a compatibility shim —
+// they delegate to the same internal core and log a one-time
+// deprecation notice — so existing users can migrate call by call.
+
+// deprecated logs the one-time migration notice of a variadic shim.
+func deprecated(name, replacement string) {
+	internal.WarnOnce("deprecated-"+name, "variadic call form is deprecated", "func", name, "use", replacement)
+}
+
+// TextAt renders a piece of text at an explicit position.
+func TextAt(frame *gocv.Mat, x, y int, text string, fontScale float64, hexColor uint32) {
+	internal.Text(internal.ScreenBlock(frame), x, y, text, fontScale, hexColor, false)
+}
+
+// ButtonAt renders a button of an explicit size and reports clicks.
+func ButtonAt(frame *gocv.Mat, x, y, width, height int, label string) bool {
+	return internal.Button(internal.ScreenBlock(frame), x, y, width, height, label, false)
+}
+
+// CheckboxAt renders a checkbox bound to state and returns its current
+// value.
+func CheckboxAt(frame *gocv.Mat, x, y int, label string, state *bool, hexColor uint32) bool {
+	return internal.Checkbox(internal.ScreenBlock(frame), x, y, label, state, hexColor, false)
+}
+
+// CounterAt renders an integer counter with increment/decrement
+// buttons.
+func CounterAt(frame *gocv.Mat, x, y int, value *int, step int, format string) {
+	internal.Counter(internal.ScreenBlock(frame), x, y, value, step, format, false)
+}
+
+// CounterFloatAt renders a float counter with increment/decrement
+// buttons.
+func CounterFloatAt(frame *gocv.Mat, x, y int, value *float64, step float64, format string) {
+	internal.CounterFloat(internal.ScreenBlock(frame), x, y, value, step, format, false)
+}
+
+// TrackbarAt renders a trackbar bound to value and returns true when
+// the value changes.
+func TrackbarAt(frame *gocv.Mat, x, y, width int, value *float64, params TrackbarParams) bool {
+	return internal.Trackbar(internal.ScreenBlock(frame), x, y, width, value, params, false)
+}
+
+// WindowAt renders a window, i.e. a filled rectangle with a title bar.
+func WindowAt(frame *gocv.Mat, x, y, width, height int, title string) {
+	internal.Window(internal.ScreenBlock(frame), x, y, width, height, title, false)
+}
+
+// RectangleAt renders a rectangle with a border color and a filling
+// color; use 0xffffffff for no filling.
+func RectangleAt(frame *gocv.Mat, x, y, width, height int, borderColor, fillingColor uint32) {
+	internal.Rect(internal.ScreenBlock(frame), x, y, width, height, borderColor, fillingColor, false)
+}
+
+// SparklineAt renders a slice of float64 values as a compact line
+// chart.
+func SparklineAt(frame *gocv.Mat, values []float64, x, y, width, height int, hexColor uint32) {
+	internal.Sparkline(internal.ScreenBlock(frame), values, x, y, width, height, hexColor, false)
+}
+
+// ImageAt renders a gocv.Mat at an explicit position.
+func ImageAt(frame *gocv.Mat, x, y int, image gocv.Mat) {
+	internal.Image(internal.ScreenBlock(frame), x, y, image, false)
+}
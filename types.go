@@ -0,0 +1,150 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// Point represents a 2D point.
+type Point struct {
+	X int
+	Y int
+}
+
+// NewPoint returns a Point with the given coordinates.
+func NewPoint(x, y int) Point {
+	return Point{X: x, Y: y}
+}
+
+// Inside reports whether the point lies within rect.
+func (p Point) Inside(rect Rect) bool {
+	return rect.Contains(p)
+}
+
+// Rect represents a rectangle.
+type Rect struct {
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// NewRect returns a Rect with the given position and size.
+func NewRect(x, y, width, height int) Rect {
+	return Rect{X: x, Y: y, Width: width, Height: height}
+}
+
+// Contains reports whether p lies within the rectangle.
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.X && p.X < r.X+r.Width && p.Y >= r.Y && p.Y < r.Y+r.Height
+}
+
+// Area returns the area of the rectangle.
+func (r Rect) Area() int {
+	return r.Width * r.Height
+}
+
+// Size represents the size of something, e.g. a rendered piece of text.
+type Size struct {
+	Width  int
+	Height int
+}
+
+// Label holds the pieces of a component label after parsing the '&'
+// keyboard shortcut marker, e.g. "&Quit" has shortcut 'Q'.
+type Label struct {
+	HasShortcut        bool
+	Shortcut           rune
+	TextBeforeShortcut string
+	TextAfterShortcut  string
+}
+
+// MouseButton tracks the state of a single mouse button along a frame.
+type MouseButton struct {
+	// JustReleased is true if the button was pressed and released this frame.
+	JustReleased bool
+	// JustPressed is true if the button was pressed this frame.
+	JustPressed bool
+	// Pressed is true while the button is held down.
+	Pressed bool
+	// DoubleClicked is true if the button was double-clicked this frame.
+	DoubleClicked bool
+}
+
+func (b *MouseButton) reset() {
+	b.JustPressed = false
+	b.JustReleased = false
+	b.DoubleClicked = false
+}
+
+// Mouse tracks the cursor position and the state of all mouse buttons
+// within a particular window.
+type Mouse struct {
+	Buttons   map[int]*MouseButton
+	AnyButton *MouseButton
+	Position  Point
+	// Wheel is the number of wheel notches scrolled this frame:
+	// positive away from the user, negative towards. Reset by Update.
+	Wheel int
+	// Modifiers holds the EVENT_FLAG_CTRLKEY/SHIFTKEY/ALTKEY keys
+	// held during the most recent mouse event.
+	Modifiers int
+}
+
+func newMouse() Mouse {
+	return Mouse{
+		Buttons: map[int]*MouseButton{
+			LEFT_BUTTON:   {},
+			MIDDLE_BUTTON: {},
+			RIGHT_BUTTON:  {},
+		},
+		AnyButton: &MouseButton{},
+		Position:  Point{},
+	}
+}
+
+// Context tracks the UI state of a single OpenCV window.
+type Context struct {
+	WindowName string
+	Mouse      Mouse
+	// LastKeyPressed is the last key attributed to this window, or -1.
+	// Keys are routed to the window that most recently received mouse
+	// events, the closest focus signal OpenCV exposes.
+	LastKeyPressed int
+	// Scale multiplies all rendered geometry of the context; zero
+	// means 1. Set it through SetScaleForWindow.
+	Scale float64
+}
+
+// TrackbarParams describes the behavior and rendering of a trackbar.
+type TrackbarParams struct {
+	Min         float64
+	Max         float64
+	Step        float64
+	Segments    int
+	Options     int
+	LabelFormat string
+}
+
+// Block is a rectangular area of the frame into which components are
+// rendered, either the whole screen or a begin*/end* layout group.
+type Block struct {
+	Where   *gocv.Mat
+	Rect    Rect
+	Fill    Rect
+	Anchor  Point
+	Padding int
+	Type    int
+
+	// lineHeight is the tallest child of the current line of a WRAP
+	// block.
+	lineHeight int
+}
+
+func (b *Block) reset() {
+	b.Rect = Rect{}
+	b.Fill = Rect{}
+	b.Anchor = Point{}
+	b.Padding = 0
+	b.Type = ROW
+	b.lineHeight = 0
+}
@@ -0,0 +1,133 @@
+package gocvui
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gocv.io/x/gocv"
+)
+
+// bindings is the registry UI descriptions resolve their value names
+// against.
+var bindings = map[string]interface{}{}
+
+// Bind registers a value or action under a name for use from UI
+// descriptions: *float64 and *int for trackbars, *bool for checkboxes,
+// *string for texts and func() for button actions. Binding a name
+// twice replaces the earlier target.
+func Bind(name string, target interface{}) {
+	bindings[name] = target
+}
+
+// uiWidget is one entry of a UI description.
+type uiWidget struct {
+	Type  string  `json:"type"`
+	Label string  `json:"label"`
+	Bind  string  `json:"bind"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Step  float64 `json:"step"`
+	Width int     `json:"width"`
+}
+
+// UIDef is a loaded UI description: a titled panel of widgets laid out
+// as a column, built from JSON so non-Go users can rearrange tuning
+// UIs without code changes.
+type UIDef struct {
+	Title   string     `json:"title"`
+	X       int        `json:"x"`
+	Y       int        `json:"y"`
+	Width   int        `json:"width"`
+	Height  int        `json:"height"`
+	Widgets []uiWidget `json:"widgets"`
+}
+
+// LoadUI parses a JSON UI description:
+//
+//	{"title": "Tuning", "x": 10, "y": 10, "width": 220, "height": 200,
+//	 "widgets": [
+//	   {"type": "trackbar", "label": "Threshold", "bind": "threshold",
+//	    "min": 0, "max": 255},
+//	   {"type": "checkbox", "label": "Blur", "bind": "blur"},
+//	   {"type": "button", "label": "Reset", "bind": "reset"}]}
+//
+// The bind names are resolved against the Bind registry at render
+// time.
+func LoadUI(data string) (*UIDef, error) {
+	def := &UIDef{}
+	if err := json.Unmarshal([]byte(data), def); err != nil {
+		return nil, fmt.Errorf("gocvui: parsing UI description: %w", err)
+	}
+	return def, nil
+}
+
+// Render draws the described panel for a frame, reading and writing
+// the bound values. Widgets whose binding is missing or of the wrong
+// type render a warning placeholder instead of failing.
+func (d *UIDef) Render(frame *gocv.Mat) {
+	block := internal.ScreenBlock(frame)
+	if d.Title != "" {
+		internal.Window(block, d.X, d.Y, d.Width, d.Height, d.Title, false)
+	}
+
+	width := d.Width - 2*modalPadding
+	internal.BeginBlock(frame, d.X+modalPadding, d.Y+modalTitleBarHeight+modalPadding, width, d.Height-modalTitleBarHeight-2*modalPadding, 6, COLUMN)
+	for _, widget := range d.Widgets {
+		d.renderWidget(widget, width)
+	}
+	internal.EndBlock()
+}
+
+// renderWidget draws one description entry at the current layout
+// anchor.
+func (d *UIDef) renderWidget(widget uiWidget, width int) {
+	block := internal.TopBlock()
+	if widget.Width > 0 {
+		width = widget.Width
+	}
+
+	switch widget.Type {
+	case "text":
+		label := widget.Label
+		if target, ok := bindings[widget.Bind].(*string); ok {
+			label = *target
+		}
+		internal.Text(block, block.Anchor.X, block.Anchor.Y, label, 0.4, ColorText, true)
+	case "button":
+		if internal.ButtonAuto(block, block.Anchor.X, block.Anchor.Y, widget.Label, true) {
+			if action, ok := bindings[widget.Bind].(func()); ok {
+				action()
+			}
+		}
+	case "checkbox":
+		if target, ok := bindings[widget.Bind].(*bool); ok {
+			internal.Checkbox(block, block.Anchor.X, block.Anchor.Y, widget.Label, target, ColorText, true)
+			return
+		}
+		d.renderUnresolved(block, widget)
+	case "trackbar":
+		params := TrackbarParams{Min: widget.Min, Max: widget.Max, Step: widget.Step, LabelFormat: "%.1f"}
+		switch target := bindings[widget.Bind].(type) {
+		case *float64:
+			internal.Trackbar(block, block.Anchor.X, block.Anchor.Y, width, target, params, true)
+		case *int:
+			value := float64(*target)
+			params.LabelFormat = "%.0f"
+			if internal.Trackbar(block, block.Anchor.X, block.Anchor.Y, width, &value, params, true) {
+				*target = int(value)
+			}
+		default:
+			d.renderUnresolved(block, widget)
+		}
+	default:
+		d.renderUnresolved(block, widget)
+	}
+}
+
+// renderUnresolved draws the placeholder of a widget whose type or
+// binding could not be resolved.
+func (d *UIDef) renderUnresolved(block *Block, widget uiWidget) {
+	message := fmt.Sprintf("[%s: unresolved bind \"%s\"]", widget.Type, widget.Bind)
+	internal.WarnOnce("uidesc-"+widget.Bind, "UIDef has an unresolved bind", "type", widget.Type, "bind", widget.Bind)
+	internal.Text(block, block.Anchor.X, block.Anchor.Y, message, 0.35, ColorDimText, true)
+}
@@ -0,0 +1,51 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// UILayer separates the UI from the frame it decorates: widgets draw
+// into a working copy of each clean frame, so the original can be
+// saved or streamed without widgets baked in while the composited copy
+// goes to the screen. The working Mat is reused across frames; Close
+// it when done.
+type UILayer struct {
+	composite gocv.Mat
+	ready     bool
+}
+
+// NewUILayer returns an empty layer; the working Mat is allocated on
+// the first Begin.
+func NewUILayer() *UILayer {
+	return &UILayer{}
+}
+
+// Begin starts a UI frame over clean: the clean frame is copied into
+// the layer's working Mat and that Mat is returned — pass it to the
+// widget calls instead of the clean frame. The clean frame itself is
+// never written to.
+func (l *UILayer) Begin(clean gocv.Mat) *gocv.Mat {
+	if !l.ready {
+		l.composite = gocv.NewMat()
+		l.ready = true
+	}
+	clean.CopyTo(&l.composite)
+	return &l.composite
+}
+
+// Show displays the composited frame in the window, running the usual
+// Update cycle — the UILayer equivalent of Imshow.
+func (l *UILayer) Show(windowName string) {
+	if !l.ready {
+		return
+	}
+	Imshow(windowName, &l.composite)
+}
+
+// Close releases the working Mat.
+func (l *UILayer) Close() {
+	if l.ready {
+		l.composite.Close()
+		l.ready = false
+	}
+}
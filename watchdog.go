@@ -0,0 +1,47 @@
+package gocvui
+
+import (
+	"fmt"
+	"time"
+)
+
+// EnableWatchdog sets a per-frame budget for UI rendering time. The
+// watchdog measures the span between the first component call of a
+// frame and Update(); frames exceeding the budget are reported through
+// the diagnostics logger and flagged with a warning banner on the
+// frame, making it obvious when the UI itself has become the
+// bottleneck of the vision pipeline. A budget of 0 disables it.
+func EnableWatchdog(budget time.Duration) {
+	internal.watchdogBudget = budget
+}
+
+// watchdogFrameStarted marks the beginning of the frame's UI work; the
+// first component call of the frame wins.
+func (in *Internal) watchdogFrameStarted() {
+	if in.watchdogBudget > 0 && in.frameUIStart.IsZero() {
+		in.frameUIStart = time.Now()
+	}
+}
+
+// checkWatchdog runs at Update time, closing the frame measurement and
+// rendering the over-budget banner when needed.
+func (in *Internal) checkWatchdog() {
+	if in.watchdogBudget <= 0 || in.frameUIStart.IsZero() {
+		return
+	}
+	duration := time.Since(in.frameUIStart)
+	in.frameUIStart = time.Time{}
+
+	if duration <= in.watchdogBudget {
+		return
+	}
+	in.Warn("UI render time exceeded the frame budget", "duration", duration, "budget", in.watchdogBudget)
+
+	if in.Screen.Where == nil {
+		return
+	}
+	banner := Rect{0, 0, in.Screen.Where.Cols(), 18}
+	render.rectangle(in.Screen.Where, banner, 0x803030, CVUI_FILLED)
+	message := fmt.Sprintf("UI over frame budget: %.1fms > %.1fms", float64(duration)/float64(time.Millisecond), float64(in.watchdogBudget)/float64(time.Millisecond))
+	render.putText(in.Screen.Where, message, Point{5, 13}, 0.4, 0xFFFFFF)
+}
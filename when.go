@@ -0,0 +1,32 @@
+package gocvui
+
+// When runs draw only while cond holds — a declarative way to hide a
+// group of widgets, keeping frame code for state-dependent panels
+// readable:
+//
+//	gocvui.When(recording, func() {
+//		gocvui.Text(&frame, 10, 10, "REC")
+//	})
+func When(cond bool, draw func()) {
+	if cond && draw != nil {
+		draw()
+	}
+}
+
+// EnableIf runs draw with the widgets inside disabled while cond is
+// false: they still render, but stop reacting to the mouse. Scopes
+// nest; the widgets re-enable when the outermost scope ends.
+func EnableIf(cond bool, draw func()) {
+	if draw == nil {
+		return
+	}
+	if cond {
+		draw()
+		return
+	}
+
+	wasDisabled := internal.disabled
+	internal.disabled = true
+	draw()
+	internal.disabled = wasDisabled
+}
@@ -0,0 +1,268 @@
+package gocvui
+
+import (
+	"fmt"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+// CreateLabel parses label, splitting it around the first '&' character
+// so components can render and react to keyboard shortcuts.
+func (in *Internal) CreateLabel(label string) Label {
+	var parsed Label
+	for i, c := range label {
+		if c == '&' && i+1 < len(label) {
+			parsed.HasShortcut = true
+			parsed.Shortcut = rune(label[i+1])
+			parsed.TextBeforeShortcut = label[:i]
+			parsed.TextAfterShortcut = label[i+2:]
+			return parsed
+		}
+	}
+	parsed.TextAfterShortcut = label
+	return parsed
+}
+
+// Text renders text at the block anchor (or at an explicit position for
+// the screen block) and flows the layout.
+func (in *Internal) Text(block *Block, x, y int, text string, fontScale float64, hexColor uint32, updateLayout bool) {
+	size := render.GetTextSize(text, fontScale)
+	position := Point{x, y + size.Height}
+
+	render.Text(block, text, position, fontScale, hexColor)
+	in.Describe("text", text, "", OUT, Rect{x, y, size.Width, size.Height})
+
+	if updateLayout {
+		// Add an extra pixel to the height to overcome antialiasing.
+		size.Height++
+		in.UpdateLayoutFlow(block, size)
+	}
+}
+
+// Button renders a button of an explicit size and reports clicks.
+func (in *Internal) Button(block *Block, x, y, width, height int, label string, updateLayout bool) bool {
+	shape := Rect{x, y, width, height}
+	status := in.IArea(x, y, width, height)
+
+	state := OUT
+	if status == OVER {
+		state = OVER
+	} else if status == DOWN {
+		state = DOWN
+	}
+
+	render.Button(block, state, shape)
+	size := render.GetTextSize(in.CreateLabel(label).Plain(), 0.4)
+	render.ButtonLabel(block, state, shape, label, size)
+	in.Describe("button", in.CreateLabel(label).Plain(), "", status, shape)
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{width, height})
+	}
+
+	parsed := in.CreateLabel(label)
+	if parsed.HasShortcut && (in.LastKeyPressed == int(parsed.Shortcut) || in.LastKeyPressed == int(parsed.Shortcut)+32) {
+		return true
+	}
+	return status == CLICK
+}
+
+// Plain returns the label text with the '&' marker removed.
+func (l Label) Plain() string {
+	if l.HasShortcut {
+		return l.TextBeforeShortcut + string(l.Shortcut) + l.TextAfterShortcut
+	}
+	return l.TextAfterShortcut
+}
+
+// ButtonAuto renders a button whose size is computed from its label.
+func (in *Internal) ButtonAuto(block *Block, x, y int, label string, updateLayout bool) bool {
+	size := render.GetTextSize(in.CreateLabel(label).Plain(), 0.4)
+	return in.Button(block, x, y, size.Width+30, size.Height+18, label, updateLayout)
+}
+
+// Checkbox renders a checkbox and returns its current state.
+func (in *Internal) Checkbox(block *Block, x, y int, label string, state *bool, hexColor uint32, updateLayout bool) bool {
+	shape := Rect{x, y, 15, 15}
+	size := render.GetTextSize(label, 0.4)
+	hitArea := Rect{x, y, shape.Width + size.Width + 6, shape.Height}
+	status := in.IArea(hitArea.X, hitArea.Y, hitArea.Width, hitArea.Height)
+
+	if status == CLICK {
+		*state = !*state
+	}
+
+	render.Checkbox(block, status, shape)
+	render.CheckboxLabel(block, shape, label, size, hexColor)
+	if *state {
+		render.CheckboxCheck(block, shape)
+	}
+	in.Describe("checkbox", label, fmt.Sprintf("%t", *state), status, hitArea)
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{hitArea.Width, hitArea.Height})
+	}
+	return *state
+}
+
+// Counter renders a counter for an integer value, with increment and
+// decrement buttons.
+func (in *Internal) Counter(block *Block, x, y int, value *int, step int, format string, updateLayout bool) int {
+	shape := Rect{x + 22, y, 48, 22}
+
+	if in.Button(block, x, y, 22, 22, "-", false) {
+		*value -= step
+	}
+	render.Counter(block, shape, fmt.Sprintf(format, *value))
+	if in.Button(block, shape.X+shape.Width, y, 22, 22, "+", false) {
+		*value += step
+	}
+	in.Describe("counter", "", fmt.Sprintf(format, *value), in.IArea(x, y, 22+shape.Width+22, shape.Height), Rect{x, y, 22 + shape.Width + 22, shape.Height})
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{22 + shape.Width + 22, shape.Height})
+	}
+	return *value
+}
+
+// CounterFloat renders a counter for a float value.
+func (in *Internal) CounterFloat(block *Block, x, y int, value *float64, step float64, format string, updateLayout bool) float64 {
+	shape := Rect{x + 22, y, 48, 22}
+
+	if in.Button(block, x, y, 22, 22, "-", false) {
+		*value -= step
+	}
+	render.Counter(block, shape, fmt.Sprintf(format, *value))
+	if in.Button(block, shape.X+shape.Width, y, 22, 22, "+", false) {
+		*value += step
+	}
+	in.Describe("counter", "", fmt.Sprintf(format, *value), in.IArea(x, y, 22+shape.Width+22, shape.Height), Rect{x, y, 22 + shape.Width + 22, shape.Height})
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{22 + shape.Width + 22, shape.Height})
+	}
+	return *value
+}
+
+// TrackbarValueToXPixel maps a trackbar value to an X coordinate within
+// the trackbar shape.
+func (in *Internal) TrackbarValueToXPixel(params TrackbarParams, shape Rect, value float64) int {
+	ratio := (value - params.Min) / (params.Max - params.Min)
+	position := float64(shape.X+in.TrackbarMarginX) + ratio*float64(shape.Width-2*in.TrackbarMarginX)
+	return int(position)
+}
+
+// TrackbarXPixelToValue maps an X coordinate back to a trackbar value.
+func (in *Internal) TrackbarXPixelToValue(params TrackbarParams, shape Rect, pixelX int) float64 {
+	ratio := float64(pixelX-shape.X-in.TrackbarMarginX) / float64(shape.Width-2*in.TrackbarMarginX)
+	value := params.Min + ratio*(params.Max-params.Min)
+	return math.Max(params.Min, math.Min(params.Max, value))
+}
+
+// Trackbar renders a trackbar bound to value and reports whether the
+// value changed this frame.
+func (in *Internal) Trackbar(block *Block, x, y, width int, value *float64, params TrackbarParams, updateLayout bool) bool {
+	if params.Max <= params.Min {
+		in.WarnOnce(in.StateID("trackbar.range", x, y), "trackbar has a degenerate range", "min", params.Min, "max", params.Max)
+	}
+	shape := Rect{x, y, width, 45}
+	workingArea := Rect{x + in.TrackbarMarginX, y, width - 2*in.TrackbarMarginX, 45}
+	status := in.IArea(x, y, shape.Width, shape.Height)
+	changed := false
+
+	if status == DOWN {
+		mouse := in.GetContext("").Mouse
+		newValue := in.TrackbarXPixelToValue(params, shape, mouse.Position.X)
+		if params.Options&TRACKBAR_SMOOTH != 0 {
+			newValue = in.SmoothDrag(in.StateID("trackbar.smooth", x, y), newValue, *value)
+		}
+		if params.Options&TRACKBAR_DISCRETE != 0 && params.Step != 0 {
+			if params.Options&TRACKBAR_SMOOTH != 0 {
+				newValue = snapWithHysteresis(newValue, *value, params.Step)
+			} else {
+				newValue = math.Round(newValue/params.Step) * params.Step
+			}
+		}
+		if newValue != *value {
+			*value = newValue
+			changed = true
+		}
+	}
+
+	render.TrackbarPath(block, status, shape, workingArea)
+	if params.Options&TRACKBAR_HIDE_LABELS == 0 {
+		render.TrackbarSteps(block, shape, params, workingArea)
+	}
+	render.TrackbarHandle(block, status, shape, *value, params, workingArea)
+	in.Describe("trackbar", "", fmt.Sprintf("%g", *value), status, shape)
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{shape.Width, shape.Height})
+	}
+	return changed
+}
+
+// Window renders a window with a title bar and body area.
+func (in *Internal) Window(block *Block, x, y, width, height int, title string, updateLayout bool) {
+	titleBar := Rect{x, y, width, 20}
+	content := Rect{x, y + 20, width, height - 20}
+	render.Window(block, titleBar, content, title)
+	in.Describe("window", title, "", OUT, Rect{x, y, width, height})
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{width, height})
+	}
+}
+
+// Rect renders a rectangle.
+func (in *Internal) Rect(block *Block, x, y, width, height int, borderColor, fillingColor uint32, updateLayout bool) {
+	position := Rect{x, y, width, height}
+	render.Rect(block, position, borderColor, fillingColor)
+	in.Describe("rect", "", "", OUT, position)
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{width, height})
+	}
+}
+
+// FindMinMax returns the minimum and maximum of values.
+func (in *Internal) FindMinMax(values []float64) (float64, float64) {
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max
+}
+
+// Sparkline renders values as a compact line chart.
+func (in *Internal) Sparkline(block *Block, values []float64, x, y, width, height int, hexColor uint32, updateLayout bool) {
+	rect := Rect{x, y, width, height}
+	if len(values) >= 2 {
+		min, max := in.FindMinMax(values)
+		render.Sparkline(block, values, rect, min, max, hexColor)
+	} else {
+		in.Text(block, x, y, "No data.", 0.4, 0xCECECE, false)
+	}
+	in.Describe("sparkline", "", "", OUT, rect)
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{width, height})
+	}
+}
+
+// Image renders image at (x, y) and flows the layout.
+func (in *Internal) Image(block *Block, x, y int, image gocv.Mat, updateLayout bool) {
+	position := Rect{x, y, image.Cols(), image.Rows()}
+	render.Image(block, position, image)
+	in.Describe("image", "", "", OUT, position)
+
+	if updateLayout {
+		in.UpdateLayoutFlow(block, Size{position.Width, position.Height})
+	}
+}
@@ -0,0 +1,50 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+// WindowOptions configures the window created by InitWithOptions or
+// WatchWithOptions, for when the gocv.NewWindow defaults are not
+// enough — e.g. to get a resizable window.
+type WindowOptions struct {
+	// Flags is applied to the window's autosize property:
+	// gocv.WindowNormal makes the window resizable while
+	// gocv.WindowAutosize locks it to the frame size.
+	Flags gocv.WindowFlag
+	// Width and Height set the initial window size when both are
+	// positive.
+	Width  int
+	Height int
+	// X and Y set the initial window position when either is positive.
+	X int
+	Y int
+}
+
+// apply pushes the options onto a freshly created window.
+func (o *WindowOptions) apply(window *gocv.Window) {
+	window.SetWindowProperty(gocv.WindowPropertyAutosize, o.Flags)
+	if o.Width > 0 && o.Height > 0 {
+		window.ResizeWindow(o.Width, o.Height)
+	}
+	if o.X > 0 || o.Y > 0 {
+		window.MoveWindow(o.X, o.Y)
+	}
+}
+
+// InitWithOptions initializes the library like Init, creating the
+// window of windowName with explicit flags, size and position.
+func InitWithOptions(windowName string, delayWaitKey int, options WindowOptions) {
+	internal.DefaultContext = windowName
+	internal.CurrentContext = windowName
+	internal.DelayWaitKey = delayWaitKey
+	internal.LastKeyPressed = -1
+	internal.WatchWithOptions(windowName, true, &options)
+}
+
+// WatchWithOptions starts tracking the mouse within an additional
+// window like Watch, creating it with explicit flags, size and
+// position.
+func WatchWithOptions(windowName string, options WindowOptions) {
+	internal.WatchWithOptions(windowName, true, &options)
+}
@@ -0,0 +1,109 @@
+package gocvui
+
+import (
+	"gocv.io/x/gocv"
+)
+
+const (
+	wizardHeaderHeight = 28
+	wizardFooterHeight = 35
+	wizardStepRadius   = 7
+)
+
+// WizardState carries a guided flow's progress between frames. Step is
+// the zero-based current step and Finished is set when the user clicks
+// Finish on the last one. Validate, when set, is consulted before
+// advancing; returning false keeps the wizard on the current step.
+type WizardState struct {
+	Step     int
+	Finished bool
+	Validate func(step int) bool
+
+	frame *gocv.Mat
+	area  Rect
+	steps int
+}
+
+// BeginWizard renders the step indicator header of a multi-step flow
+// like camera calibration or dataset export setup, then opens a column
+// block where the current step's widgets go. Close it with EndWizard,
+// which renders the Back and Next/Finish buttons.
+func BeginWizard(frame *gocv.Mat, x, y, width, height int, steps []string, state *WizardState) {
+	block := internal.ScreenBlock(frame)
+	area := Rect{x, y, width, height}
+	state.frame = frame
+	state.area = area
+	state.steps = len(steps)
+
+	render.rectangle(block.Where, area, ColorPanel, CVUI_FILLED)
+	render.rectangle(block.Where, area, ColorOutline, 1)
+
+	for i, title := range steps {
+		center := Point{x + 15 + i*(width-30)/maxInt(len(steps)-1, 1), y + wizardHeaderHeight/2}
+		if len(steps) == 1 {
+			center.X = x + width/2
+		}
+
+		fill := ColorInset
+		if i == state.Step {
+			fill = theme.CheckboxCheck
+		} else if i < state.Step {
+			fill = ColorBorder
+		}
+		render.circle(block.Where, center, wizardStepRadius, fill, CVUI_FILLED)
+		render.circle(block.Where, center, wizardStepRadius, ColorBorder, 1)
+
+		if i == state.Step {
+			size := render.GetTextSize(title, 0.4)
+			labelX := center.X - size.Width/2
+			if labelX < x+4 {
+				labelX = x + 4
+			}
+			if labelX+size.Width > x+width-4 {
+				labelX = x + width - 4 - size.Width
+			}
+			render.putText(block.Where, title, Point{labelX, center.Y + wizardStepRadius + size.Height + 4}, 0.4, theme.TextColor)
+		}
+	}
+
+	content := Rect{x + 10, y + wizardHeaderHeight + 18, width - 20, height - wizardHeaderHeight - wizardFooterHeight - 18}
+	internal.BeginBlock(frame, content.X, content.Y, content.Width, content.Height, 5, COLUMN)
+}
+
+// EndWizard closes the block opened by BeginWizard and renders the
+// navigation buttons: Back on all but the first step, and Next or, on
+// the last step, Finish. Advancing runs the state's Validate callback
+// first, when one is set.
+func EndWizard(state *WizardState) {
+	internal.EndBlock()
+	block := internal.ScreenBlock(state.frame)
+	area := state.area
+
+	buttonY := area.Y + area.Height - wizardFooterHeight + 5
+	nextLabel := "Next"
+	if state.Step == state.steps-1 {
+		nextLabel = "Finish"
+	}
+
+	if internal.Button(block, area.X+area.Width-10-60, buttonY, 60, 25, nextLabel, false) {
+		if state.Validate == nil || state.Validate(state.Step) {
+			if state.Step == state.steps-1 {
+				state.Finished = true
+			} else {
+				state.Step++
+			}
+		}
+	}
+	if state.Step > 0 {
+		if internal.Button(block, area.X+area.Width-10-60-5-60, buttonY, 60, 25, "Back", false) {
+			state.Step--
+		}
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,195 @@
+package gocvui
+
+import (
+	"encoding/json"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	zoneVertexRadius  = 3
+	zoneVertexHit     = 6
+	zoneCloseDistance = 8
+	zoneArrowLength   = 12
+)
+
+// Zone is one surveillance rule region: an intrusion area when it has
+// three or more points, or a counting line when it has exactly two, in
+// which case a direction arrow is drawn on its normal. A zone with no
+// points is picked up by ZoneEditor as the drawing target.
+type Zone struct {
+	Name    string  `json:"name"`
+	Color   uint32  `json:"color"`
+	Points  []Point `json:"points"`
+	Enabled bool    `json:"enabled"`
+}
+
+// zoneEditorState tracks the in-progress drawing and vertex drag.
+type zoneEditorState struct {
+	Drawing    int
+	DragZone   int
+	DragVertex int
+}
+
+// ZoneEditor renders the zone set and lets the user edit it: append an
+// empty zone to start drawing one — clicks then add vertices, clicking
+// the first vertex again closes an area and Enter finishes a two-point
+// counting line. Existing vertices are dragged with the mouse and each
+// zone carries a small toggle at its first vertex to enable or disable
+// it. Use ExportZones and ImportZones to persist the set.
+func ZoneEditor(frame *gocv.Mat, zones []*Zone) {
+	block := internal.ScreenBlock(frame)
+	mouse := internal.GetContext("").Mouse
+
+	state := internal.State(internal.StateID("zoneeditor", 0, 0), func() interface{} {
+		return &zoneEditorState{Drawing: -1, DragZone: -1}
+	}).(*zoneEditorState)
+
+	if state.Drawing >= len(zones) {
+		state.Drawing = -1
+	}
+	if state.Drawing < 0 {
+		for i, zone := range zones {
+			if len(zone.Points) == 0 {
+				state.Drawing = i
+				break
+			}
+		}
+	}
+	drawing := state.Drawing
+
+	if state.DragZone >= 0 {
+		if state.DragZone >= len(zones) || !mouse.AnyButton.Pressed {
+			state.DragZone = -1
+		} else {
+			zones[state.DragZone].Points[state.DragVertex] = mouse.Position
+		}
+	}
+
+	for i, zone := range zones {
+		if i == drawing {
+			renderZoneDraft(block, zone, mouse.Position)
+			continue
+		}
+		renderZone(block, zone)
+		if len(zone.Points) > 0 && renderLandmarkToggle(block, Rect{zone.Points[0].X, zone.Points[0].Y, 0, 0}, zone.Enabled) {
+			zone.Enabled = !zone.Enabled
+		}
+
+		if state.DragZone < 0 && mouse.AnyButton.JustPressed {
+			for v, point := range zone.Points {
+				if pointDistance(point, mouse.Position) <= zoneVertexHit {
+					state.DragZone = i
+					state.DragVertex = v
+					break
+				}
+			}
+		}
+	}
+
+	if drawing >= 0 && state.DragZone < 0 {
+		zone := zones[drawing]
+		if mouse.AnyButton.JustReleased {
+			if len(zone.Points) >= 3 && pointDistance(zone.Points[0], mouse.Position) <= zoneCloseDistance {
+				zone.Enabled = true
+				state.Drawing = -1
+			} else {
+				zone.Points = append(zone.Points, mouse.Position)
+			}
+		}
+		if (internal.LastKeyPressed == 13 || internal.LastKeyPressed == 10) && len(zone.Points) >= 2 {
+			zone.Enabled = true
+			state.Drawing = -1
+		}
+	}
+}
+
+// ExportZones serializes a zone set as JSON.
+func ExportZones(zones []*Zone) (string, error) {
+	data, err := json.MarshalIndent(zones, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ImportZones rebuilds a zone set from the JSON produced by
+// ExportZones.
+func ImportZones(data string) ([]*Zone, error) {
+	var zones []*Zone
+	if err := json.Unmarshal([]byte(data), &zones); err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+// renderZone draws a finished zone: a closed polygon, or a counting
+// line with its direction arrow when it has two points.
+func renderZone(block *Block, zone *Zone) {
+	hexColor := zoneColor(zone)
+
+	for i, point := range zone.Points {
+		if i > 0 {
+			render.line(block.Where, zone.Points[i-1], point, hexColor)
+		}
+		render.circle(block.Where, point, zoneVertexRadius, hexColor, CVUI_FILLED)
+	}
+	if len(zone.Points) >= 3 {
+		render.line(block.Where, zone.Points[len(zone.Points)-1], zone.Points[0], hexColor)
+	}
+	if len(zone.Points) == 2 {
+		renderZoneArrow(block, zone.Points[0], zone.Points[1], hexColor)
+	}
+	if len(zone.Points) > 0 && zone.Name != "" {
+		render.putText(block.Where, zone.Name, Point{zone.Points[0].X + 12, zone.Points[0].Y + 4}, 0.3, hexColor)
+	}
+}
+
+// renderZoneDraft draws the zone being drawn, with a rubber line from
+// the last vertex to the cursor.
+func renderZoneDraft(block *Block, zone *Zone, cursor Point) {
+	hexColor := zoneColor(zone)
+	for i, point := range zone.Points {
+		if i > 0 {
+			render.line(block.Where, zone.Points[i-1], point, hexColor)
+		}
+		render.circle(block.Where, point, zoneVertexRadius, hexColor, 1)
+	}
+	if len(zone.Points) > 0 {
+		render.line(block.Where, zone.Points[len(zone.Points)-1], cursor, ColorDimText)
+	}
+}
+
+// renderZoneArrow draws the crossing-direction arrow on the normal of a
+// counting line.
+func renderZoneArrow(block *Block, a, b Point, hexColor uint32) {
+	midX := float64(a.X+b.X) / 2
+	midY := float64(a.Y+b.Y) / 2
+	angle := math.Atan2(float64(b.Y-a.Y), float64(b.X-a.X)) - math.Pi/2
+
+	tip := Point{int(midX + zoneArrowLength*math.Cos(angle)), int(midY + zoneArrowLength*math.Sin(angle))}
+	render.line(block.Where, Point{int(midX), int(midY)}, tip, hexColor)
+	for _, side := range []float64{-1, 1} {
+		wing := angle + math.Pi + side*math.Pi/6
+		end := Point{tip.X + int(trailArrowHead*math.Cos(wing)), tip.Y + int(trailArrowHead*math.Sin(wing))}
+		render.line(block.Where, tip, end, hexColor)
+	}
+}
+
+// zoneColor is the zone's color, dimmed while disabled.
+func zoneColor(zone *Zone) uint32 {
+	hexColor := zone.Color
+	if hexColor == 0 {
+		hexColor = ColorHighlight
+	}
+	if !zone.Enabled && len(zone.Points) > 0 {
+		hexColor = lerpColor(ColorPanel, hexColor, 0.4)
+	}
+	return hexColor
+}
+
+// pointDistance is the euclidean distance between two points.
+func pointDistance(a, b Point) float64 {
+	return math.Hypot(float64(a.X-b.X), float64(a.Y-b.Y))
+}